@@ -11,7 +11,8 @@ export async function GET(request: NextRequest) {
     }
 
     const backendUrl = (process.env.BACKEND_API_URL || 'http://0.0.0.0:8080').replace(/\/$/, '');
-    const targetUrl = `${backendUrl}/milestones?birth_date=${birthDate}`;
+    // region や temperature などのパラメータもそのままバックエンドへ渡す
+    const targetUrl = `${backendUrl}/milestones?${searchParams.toString()}`;
 
     console.log(`[Proxy] Target: ${targetUrl}`);
 
@@ -55,28 +55,26 @@ export default function Home() {
         )}
 
         {/* 結果表示 */}
-        {data && (
-          <section className="relative transition-all duration-500">
-            <div className="absolute -top-6 -left-2 text-xs font-black uppercase tracking-widest text-indigo-200 select-none">
-              Milestones
-            </div>
-            <div className={loading ? 'opacity-40 grayscale-[0.5] pointer-events-none blur-[1px] transition-all duration-300' : 'transition-all duration-300'}>
-              <RecommendationResult result={data} />
-            </div>
+        <section className="relative transition-all duration-500">
+          <div className="absolute -top-6 -left-2 text-xs font-black uppercase tracking-widest text-indigo-200 select-none">
+            Milestones
+          </div>
+          <div className={loading ? 'opacity-40 grayscale-[0.5] pointer-events-none blur-[1px] transition-all duration-300' : 'transition-all duration-300'}>
+            <RecommendationResult result={data} />
+          </div>
 
-            {loading && (
-              <div className="absolute inset-0 flex flex-col items-center justify-center gap-4 z-20">
-                <div className="relative">
-                  <div className="h-16 w-16 animate-spin rounded-full border-4 border-blue-500/20 border-t-blue-600" />
-                  <div className="absolute inset-0 flex items-center justify-center text-xl">👶</div>
-                </div>
-                <p className="text-sm font-black text-blue-600 animate-pulse bg-white/80 px-4 py-1 rounded-full shadow-sm backdrop-blur-sm">
-                  マイルストーンを更新中…
-                </p>
+          {loading && (
+            <div className="absolute inset-0 flex flex-col items-center justify-center gap-4 z-20">
+              <div className="relative">
+                <div className="h-16 w-16 animate-spin rounded-full border-4 border-blue-500/20 border-t-blue-600" />
+                <div className="absolute inset-0 flex items-center justify-center text-xl">👶</div>
               </div>
-            )}
-          </section>
-        )}
+              <p className="text-sm font-black text-blue-600 animate-pulse bg-white/80 px-4 py-1 rounded-full shadow-sm backdrop-blur-sm">
+                マイルストーンを更新中…
+              </p>
+            </div>
+          )}
+        </section>
       </main>
 
       {/* フッター */}
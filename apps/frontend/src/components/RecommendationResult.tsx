@@ -1,14 +1,21 @@
 'use client';
 
 import React, { useState } from 'react';
-import { MilestoneResponse, Milestone } from '@/hooks/useMilestones';
+import { MilestoneResponse, Milestone, upcomingMonthStarts } from '@/hooks/useMilestones';
 
 interface RecommendationResultProps {
-    result: MilestoneResponse;
+    // 生年月日の入力前は null で、今後の予定日だけをタイムラインに並べる
+    result: MilestoneResponse | null;
 }
 
-const MilestoneCard: React.FC<{ milestone: Milestone; isSelected: boolean; onClick: () => void }> = ({
-    milestone,
+// タイムラインの1枠。生年月日の入力前は日付だけを持つ
+interface TimelineEntry {
+    date: string;
+    milestone?: Milestone;
+}
+
+const MilestoneCard: React.FC<{ entry: TimelineEntry; isSelected: boolean; onClick: () => void }> = ({
+    entry,
     isSelected,
     onClick,
 }) => {
@@ -21,9 +28,9 @@ const MilestoneCard: React.FC<{ milestone: Milestone; isSelected: boolean; onCli
             <div className={`h-1 mx-auto mb-4 rounded-full ${isSelected ? 'bg-blue-600 w-full' : 'bg-gray-200 w-1/2'}`} />
             <div className="text-center">
                 <p className={`text-xs font-bold ${isSelected ? 'text-blue-600' : 'text-gray-500'}`}>
-                    {milestone.target_date}
+                    {entry.date}
                 </p>
-                <p className="text-[10px] text-gray-400">{milestone.items.length === 0 ? '予定' : `${milestone.age_in_months}ヶ月`}</p>
+                <p className="text-[10px] text-gray-400">{entry.milestone ? `${entry.milestone.age_in_months}ヶ月` : '予定'}</p>
             </div>
         </div>
     );
@@ -50,27 +57,30 @@ const RecommendationResult: React.FC<RecommendationResultProps> = ({ result }) =
     const currentMonthStart = new Date(now.getFullYear(), now.getMonth(), 1);
 
     // 現在の月以降のマイルストーンのみを表示対象とする
-    const displayedMilestones = result.milestones.filter(m => {
-        const d = new Date(m.target_date);
+    const entries: TimelineEntry[] = result
+        ? result.milestones.map(m => ({ date: m.target_date, milestone: m }))
+        : upcomingMonthStarts().map(date => ({ date }));
+    const displayedEntries = entries.filter(e => {
+        const d = new Date(e.date);
         return d >= currentMonthStart;
     });
 
-    const activeIndex = selectedIndex >= displayedMilestones.length ? 0 : selectedIndex;
-    const selectedMilestone = displayedMilestones[activeIndex];
+    const activeIndex = selectedIndex >= displayedEntries.length ? 0 : selectedIndex;
+    const selectedEntry = displayedEntries[activeIndex];
 
-    if (!selectedMilestone) return null;
+    if (!selectedEntry) return null;
 
-    const isInitial = selectedMilestone.items.length === 0;
+    const selectedMilestone = selectedEntry.milestone;
 
     return (
         <div className="w-full space-y-8 animate-fade-in">
             {/* タイムライン (横スクロール) */}
             <div className="relative pb-4">
                 <div className="flex overflow-x-auto pb-4 gap-4 no-scrollbar scroll-smooth px-4">
-                    {displayedMilestones.map((m, idx) => (
+                    {displayedEntries.map((e, idx) => (
                         <MilestoneCard
                             key={idx}
-                            milestone={m}
+                            entry={e}
                             isSelected={idx === activeIndex}
                             onClick={() => setSelectedIndex(idx)}
                         />
@@ -84,23 +94,23 @@ const RecommendationResult: React.FC<RecommendationResultProps> = ({ result }) =
             <div className="bg-white rounded-3xl shadow-xl overflow-hidden border border-gray-100">
                 <div className="bg-gradient-to-r from-blue-600 to-indigo-500 p-6 text-white text-center">
                     <div className="flex justify-center items-center gap-4 mb-2">
-                        <span className="text-4xl">{isInitial ? '🗓️' : '🍼'}</span>
+                        <span className="text-4xl">{selectedMilestone ? '🍼' : '🗓️'}</span>
                         <div className="text-left">
                             <p className="text-sm font-medium opacity-80">
-                                {isInitial ? '未来の成長ライン' : `生後 ${selectedMilestone.age_in_months} ヶ月頃`}
+                                {selectedMilestone ? `生後 ${selectedMilestone.age_in_months} ヶ月頃` : '未来の成長ライン'}
                             </p>
                             <h3 className="text-2xl font-black">
-                                {selectedMilestone.target_date} {isInitial ? 'の予定' : 'のおすすめ'}
+                                {selectedEntry.date} {selectedMilestone ? 'のおすすめ' : 'の予定'}
                             </h3>
                         </div>
                     </div>
                     <div className="inline-block bg-white/20 backdrop-blur-md rounded-full px-4 py-1 text-sm font-bold">
-                        {isInitial ? '📏 サイズをチェック' : `📏 目安サイズ: ${selectedMilestone.size}`}
+                        {selectedMilestone ? `📏 目安サイズ: ${selectedMilestone.size}` : '📏 サイズをチェック'}
                     </div>
                 </div>
 
                 <div className="p-6">
-                    {selectedMilestone.items.length > 0 ? (
+                    {selectedMilestone && selectedMilestone.items.length > 0 ? (
                         <div className="grid gap-4">
                             {selectedMilestone.items.map((item, idx) => {
                                 return (
@@ -1,11 +1,14 @@
 import { useState } from 'react';
-import { components } from '@/types/openapi';
+import { components, operations } from '@/types/openapi';
 
 export type Milestone = components['schemas']['Milestone'];
 export type MilestoneResponse = components['schemas']['MilestoneResponse'];
+// birth_date 以外の GET /milestones のクエリパラメータ
+export type MilestoneOptions = Omit<operations['getMilestones']['parameters']['query'], 'birth_date'>;
 
-const generateInitialMilestones = (): MilestoneResponse => {
-  const milestones: Milestone[] = [];
+// 生年月日の入力前にタイムラインへ並べる、今月から12ヶ月先までの月初日を返す
+export const upcomingMonthStarts = (): string[] => {
+  const dates: string[] = [];
   const now = new Date();
 
   for (let i = 0; i <= 12; i++) {
@@ -14,28 +17,28 @@ const generateInitialMilestones = (): MilestoneResponse => {
     const m = String(targetDate.getMonth() + 1).padStart(2, '0');
     const d = String(targetDate.getDate()).padStart(2, '0');
 
-    milestones.push({
-      age_in_months: i,
-      target_date: `${y}-${m}-${d}`,
-      size: '不明',
-      items: [],
-    });
+    dates.push(`${y}-${m}-${d}`);
   }
-  return { milestones };
+  return dates;
 };
 
 export const useMilestones = () => {
-  const [data, setData] = useState<MilestoneResponse | null>(() => generateInitialMilestones());
+  const [data, setData] = useState<MilestoneResponse | null>(null);
   const [loading, setLoading] = useState(false);
   const [error, setError] = useState<string | null>(null);
 
-  const fetchMilestones = async (birthDate: string) => {
+  const fetchMilestones = async (birthDate: string, options: MilestoneOptions = {}) => {
     setLoading(true);
     setError(null);
     try {
       const query = new URLSearchParams({
         birth_date: birthDate,
       });
+      for (const [key, value] of Object.entries(options)) {
+        if (value !== undefined) {
+          query.set(key, String(value));
+        }
+      }
 
       const response = await fetch(`/api/milestones?${query.toString()}`);
       if (!response.ok) {
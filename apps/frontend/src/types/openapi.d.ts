@@ -13,7 +13,7 @@ export interface paths {
         };
         /**
          * Get baby wear milestones
-         * @description Returns a list of recommended baby wear items for each month from birth to 2 years old.
+         * @description Returns a list of recommended baby wear items for each milestone. By default milestones are monthly from birth to 2 years old; use from_month, to_month, step and granularity to choose another range (up to 6 years old).
          */
         get: operations["getMilestones"];
         put?: never;
@@ -24,16 +24,174 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/outfit": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Get the outfit for a single date
+         * @description Returns what the baby should wear on one date: the size, the outfit for each context with shop names, and the temperature the outfit was chosen for. Uses the same logic as the milestones.
+         */
+        get: operations["getOutfit"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/items": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List catalog items
+         * @description Returns every universal item in the catalog with its category, the age and temperature ranges it is recommended for, and its name at every shop.
+         */
+        get: operations["listItems"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/items/{universal_name}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get a catalog item */
+        get: operations["getItem"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/items/translate": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Translate a shop-specific item name
+         * @description Looks up the universal item for a shop-specific product name (e.g. "プレオール") and returns its category and the equivalent names at the other shops. Katakana/hiragana and long-vowel variants are matched (e.g. "ボディスーツ" and "ボディースーツ").
+         */
+        get: operations["translateItem"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/items/search": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Search items
+         * @description Fuzzy search over universal names, readings, shop-specific names and category labels. Full-width/half-width characters, hiragana/katakana, long-vowel marks and small kana are normalized before matching, so "こんびはだぎ" or "ﾛﾝﾊﾟｰｽ" find the right item. Results are ranked by edit distance / bigram similarity.
+         */
+        get: operations["searchItems"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
 }
 export type webhooks = Record<string, never>;
 export interface components {
     schemas: {
+        /** @description Error response in the RFC 7807 problem details format (application/problem+json) */
+        Problem: {
+            /**
+             * @description Always "about:blank"; use code to tell errors apart
+             * @example about:blank
+             */
+            type: string;
+            /**
+             * @description HTTP status text
+             * @example Bad Request
+             */
+            title: string;
+            /**
+             * @description HTTP status code
+             * @example 400
+             */
+            status: number;
+            /**
+             * @description Human-readable explanation of this occurrence (English, not stable)
+             * @example birth date is out of range: birth date must be between 2020-10-18 and 2027-08-22
+             */
+            detail?: string;
+            code: components["schemas"]["ProblemCode"];
+        };
+        /**
+         * @description Stable error code. invalid_parameter: a parameter is missing or malformed (wrong type, unknown enum value, unknown shop, limit out of range). birth_date_out_of_range: birth_date is more than 72 months ago or more than 44 weeks ahead. due_date_out_of_range: due_date is unrealistic for birth_date. date_out_of_range: date is before birth_date or more than 72 months after it. invalid_milestone_range: from_month, to_month or step is out of range. invalid_measurement: height_cm, weight_kg, sex or measured_on is unrealistic. invalid_temperature: temperature is out of the accepted range. unknown_region: region does not match any region or prefecture. unsupported_language: lang is not a supported language. item_not_found: no catalog item matches (404).
+         * @enum {string}
+         */
+        ProblemCode: "invalid_parameter" | "birth_date_out_of_range" | "due_date_out_of_range" | "date_out_of_range" | "invalid_milestone_range" | "invalid_measurement" | "invalid_temperature" | "unknown_region" | "unsupported_language" | "item_not_found";
+        /**
+         * @description Temperature unit (celsius = ℃, fahrenheit = ℉)
+         * @enum {string}
+         */
+        TemperatureUnit: "celsius" | "fahrenheit";
+        /**
+         * @description Where the temperature came from. estimated is the estimate for the date and region; specified is the temperature parameter.
+         * @enum {string}
+         */
+        TemperatureSource: "estimated" | "specified";
+        /**
+         * @description Season of the date in the region: spring (Mar-May), summer (Jun-Aug), autumn (Sep-Nov) and winter (Dec-Feb), except that the region's usual rainy season (梅雨, from its normal start to end dates) is rainy_season. Hokkaido has no rainy season.
+         * @enum {string}
+         */
+        Season: "spring" | "rainy_season" | "summer" | "autumn" | "winter";
+        /**
+         * @description Rough band of the temperature used (mean). The boundaries come from the rule set's temperature_bands and line up with its rule thresholds; with the default rules they are cold (below 10℃), chilly (10-15℃), cool (15-20℃), mild (20-25℃) and hot (25℃ and above).
+         * @enum {string}
+         */
+        TemperatureBand: "cold" | "chilly" | "cool" | "mild" | "hot";
+        /**
+         * @description Sex used to pick the growth curve
+         * @enum {string}
+         */
+        Sex: "male" | "female";
         Item: {
             /**
              * @description Universal name of the item (e.g., combi-hadagi)
              * @example コンビ肌着
              */
             universal_name: string;
+            /**
+             * @description Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+             * @example Short undershirt (短肌着)
+             */
+            display_name: string;
+            /** @description Short description of the item in the requested language */
+            description?: string;
             /** @description Shop-specific names of the item */
             shop_names: components["schemas"]["ShopNameStatus"][];
             /**
@@ -51,7 +209,95 @@ export interface components {
              * @example #FFF3E0
              */
             category_color: string;
+            reason: components["schemas"]["Reason"];
+            layer?: components["schemas"]["Layer"];
+            /**
+             * Format: double
+             * @description Warmth of the item in clo (0 when unknown)
+             * @example 0.45
+             */
+            warmth: number;
+            /**
+             * @description Item chosen by the rules that this warmer item of the same layer replaced because the outfit was below its target warmth. Omitted when the item was not replaced.
+             * @example ロンパース
+             */
+            replaces?: string;
+            /** @description Items of the same layer and about the same warmth (within 0.1 clo) that can be worn instead, in catalog order */
+            equivalents: components["schemas"]["Equivalent"][];
         };
+        /**
+         * @description Clothing layer, from the inside out: inner (underwear), middle (clothes worn over it), outer (worn over clothes, including sleep sacks) and accessory (hats, socks, ...)
+         * @example middle
+         * @enum {string}
+         */
+        Layer: "inner" | "middle" | "outer" | "accessory";
+        /** @description An item that can replace another item of the same layer */
+        Equivalent: {
+            /** @example ツーウェイオール */
+            universal_name: string;
+            /** @example Two-way all (ツーウェイオール) */
+            display_name: string;
+            /**
+             * Format: double
+             * @description Warmth of the item in clo
+             * @example 0.3
+             */
+            warmth: number;
+        };
+        /** @description Why the item was recommended */
+        Reason: {
+            /**
+             * @description ID of the recommendation rule that matched
+             * @example newborn-cold
+             */
+            rule_id: string;
+            /**
+             * @description ID of the rule group (e.g., inner, layer) the rule belongs to
+             * @example layer
+             */
+            rule_group: string;
+            age_band: components["schemas"]["Range"];
+            /**
+             * Format: double
+             * @description Temperature (℃) the rule was evaluated with
+             * @example 12.3
+             */
+            temperature: number;
+            /**
+             * @description Which daily temperature was used (mean = daily mean, min = morning/evening low)
+             * @example mean
+             * @enum {string}
+             */
+            temperature_basis: "mean" | "min";
+            temperature_band: components["schemas"]["Range"];
+            /**
+             * Format: double
+             * @description Set only when the item replaced a lighter one because the outfit was below its target warmth: the target (clo) it was chosen to reach. The rule is then the one that recommends this item at the band closest to the temperature.
+             * @example 0.6
+             */
+            target_warmth?: number;
+            /**
+             * @description Human-readable explanation in the display language (see lang)
+             * @example 月齢2ヶ月（4ヶ月未満）、推定気温12.3℃（15℃未満）のため
+             */
+            message: string;
+        };
+        /** @description Half-open range [min, max). An omitted bound is unbounded. */
+        Range: {
+            /**
+             * Format: double
+             * @description Inclusive lower bound
+             * @example 15
+             */
+            min?: number;
+            /**
+             * Format: double
+             * @description Exclusive upper bound
+             * @example 20
+             */
+            max?: number;
+        };
+        /** @description The item's name at one shop. Entries are always ordered by the shop's priority. */
         ShopNameStatus: {
             /**
              * @description Unique key for the shop
@@ -63,13 +309,80 @@ export interface components {
              * @example コットンフライスコンビ肌着
              */
             shop_name: string;
+            /**
+             * @description Display name of the shop
+             * @example ユニクロ
+             */
+            display_name: string;
+            /** @description URL of the shop logo, if registered */
+            logo_url?: string;
+            /**
+             * @description Brand color of the shop (#RRGGBB), if registered
+             * @example #FF0000
+             */
+            brand_color?: string;
+            /**
+             * @description Display order of the shop (smaller comes first)
+             * @example 20
+             */
+            priority: number;
+            /**
+             * @description Whether the shop sells this item in the milestone's size
+             * @example true
+             */
+            available: boolean;
+            /** @description Purchasable products at this shop in the milestone's size. Empty when none are registered. The built-in catalog ships no products, so this stays empty until the server is started with a catalog file (CATALOG_FILE) that lists them. */
+            offers: components["schemas"]["Offer"][];
+        };
+        /** @description A concrete product that can be bought at a shop */
+        Offer: {
+            /** @description Shop's product number */
+            sku?: string;
+            /**
+             * @description JAN (EAN) code
+             * @example 4006381333931
+             */
+            jan?: string;
+            /** @description Product page URL */
+            url: string;
+            price: components["schemas"]["PriceRange"];
+            /**
+             * @description Sizes (cm) the product is sold in
+             * @example [
+             *       50,
+             *       60
+             *     ]
+             */
+            sizes: number[];
+            /** @example 綿100% */
+            material?: string;
+        };
+        /** @description Price range including tax */
+        PriceRange: {
+            /** @example 990 */
+            min: number;
+            /** @example 1290 */
+            max: number;
+            /** @example JPY */
+            currency: string;
         };
+        /** @description Size and outfits at one point in time. temperature is the outdoor temperature the outfits were chosen for (℃); estimated_temperature is the temperature estimated for the date and region, which differs from temperature only when the temperature parameter is given. season and temperature_band explain the outfits at a glance. */
         Milestone: {
             /**
-             * @description Age in months at this milestone
+             * @description Chronological age in months (counted from the birth date) at this milestone
              * @example 0
              */
             age_in_months: number;
+            /**
+             * @description Age in weeks (counted from the birth date). Present only for the weekly milestones of granularity=weekly.
+             * @example 2
+             */
+            age_in_weeks?: number;
+            /**
+             * @description Corrected age in months (counted from the due date) at this milestone. Equals age_in_months when no due date is given or the baby was not born early. Used to choose the size and items.
+             * @example 0
+             */
+            corrected_age_in_months: number;
             /**
              * Format: date
              * @description The date corresponding to this milestone
@@ -77,20 +390,388 @@ export interface components {
              */
             target_date: string;
             /**
-             * @description Estimated clothing size in cm
+             * @description Estimated clothing size in cm (legacy free-form label kept for compatibility; prefer size_detail). Every size above 90cm stays "90cm+" here; size_detail tells 90-95, 100, 110 and 120 apart.
              * @example 50-60cm
              */
             size: string;
-            /** @description List of recommended items */
+            size_detail: components["schemas"]["Size"];
+            /**
+             * Format: double
+             * @description Height projected along the baby's growth percentile at this milestone. Present only when height_cm or weight_kg is given.
+             * @example 67.8
+             */
+            estimated_height_cm?: number;
+            temperature: components["schemas"]["Temperature"];
+            temperature_source: components["schemas"]["TemperatureSource"];
+            estimated_temperature: components["schemas"]["Temperature"];
+            season: components["schemas"]["Season"];
+            /**
+             * @description Localized name of the season
+             * @example 冬
+             */
+            season_label: string;
+            temperature_band: components["schemas"]["TemperatureBand"];
+            /**
+             * @description Localized name of the temperature band
+             * @example 寒い
+             */
+            temperature_band_label: string;
+            /** @description List of recommended items. Same as the items of the outing outfit; kept for compatibility (prefer outfits). */
             items: components["schemas"]["Item"][];
+            /** @description One outfit per context, in the order indoor, outing, sleep */
+            outfits: components["schemas"]["Outfit"][];
+        };
+        /** @description Recommended outfit for one context. Each context has its own temperature: indoors is assumed to be heated or cooled to 20-24℃, outings use the outdoor temperature, and sleep uses the bedroom temperature at night (18-24℃, following the overnight low). */
+        Outfit: {
+            /**
+             * @description Where the baby wears the outfit
+             * @example indoor
+             * @enum {string}
+             */
+            context: "indoor" | "outing" | "sleep";
+            /**
+             * @description Localized name of the context
+             * @example 室内
+             */
+            label: string;
+            temperature: components["schemas"]["Temperature"];
+            /** @description Recommended items for this context, from the inner layer out. The sleep outfit adds a sleep sack instead of a blanket when the bedroom is cool. */
+            items: components["schemas"]["Item"][];
+            /**
+             * Format: double
+             * @description Total warmth of the items in clo
+             * @example 0.7
+             */
+            warmth: number;
+            /**
+             * Format: double
+             * @description Warmth (clo) the outfit should reach for the context's mean temperature and the baby's age. When warmth is below it even after replacing items with warmer ones, add a blanket or avoid going out.
+             * @example 0.77
+             */
+            target_warmth: number;
+            /** @description Other outfits made by swapping items with the rule set's substitution table, best first (fewest swaps, then the least warmth above what is required). Swapped-in items are only ones the rules recommend for the context at this age and that some shop sells in the milestone's size. Every alternative reaches target_warmth, or the outfit's own warmth when the outfit falls short of the target. At most 3. */
+            alternatives: components["schemas"]["AlternativeOutfit"][];
+        };
+        AlternativeOutfit: {
+            /** @description Items of the alternative outfit, from the inner layer out. Swapped-in items carry the reason of the item they replace. */
+            items: components["schemas"]["Item"][];
+            /**
+             * Format: double
+             * @description Total warmth of the items in clo
+             * @example 0.6
+             */
+            warmth: number;
+            /** @description Swaps applied to the outfit */
+            substitutions: components["schemas"]["Substitution"][];
+        };
+        /** @description A set of items replaced together by another set of items */
+        Substitution: {
+            /**
+             * @example [
+             *       "コンビ肌着",
+             *       "カバーオール"
+             *     ]
+             */
+            from: string[];
+            /**
+             * @example [
+             *       "ボディースーツ",
+             *       "ロンパース"
+             *     ]
+             */
+            to: string[];
+        };
+        /** @description Daily temperature (℃) */
+        Temperature: {
+            /**
+             * Format: double
+             * @description Daily mean
+             * @example 20
+             */
+            mean: number;
+            /**
+             * Format: double
+             * @description Daily low (morning/evening)
+             * @example 20
+             */
+            min: number;
+            /**
+             * Format: double
+             * @description Daily high (daytime)
+             * @example 22.5
+             */
+            max: number;
+        };
+        /** @description Clothing size as a range of Japanese size labels (cm). A Japanese size fits babies about ±5cm around the label, so size 80 fits 75-85cm. */
+        Size: {
+            /**
+             * @description Canonical size label
+             * @example 50-60
+             */
+            label: string;
+            /**
+             * @description Smallest Japanese size label in the range (cm)
+             * @example 50
+             */
+            min_cm: number;
+            /**
+             * @description Largest Japanese size label in the range (cm)
+             * @example 60
+             */
+            max_cm: number;
+            conversions: components["schemas"]["SizeConversions"];
+        };
+        /** @description Equivalent sizes in other size systems, smallest first */
+        SizeConversions: {
+            /**
+             * @description US baby sizes (months / toddler)
+             * @example [
+             *       "NB",
+             *       "0-3M",
+             *       "3-6M"
+             *     ]
+             */
+            us: string[];
+            /**
+             * @description EU sizes (body height in cm)
+             * @example [
+             *       "50",
+             *       "56",
+             *       "62"
+             *     ]
+             */
+            eu: string[];
+        };
+        /** @description What to wear on one date. temperature is the outdoor temperature used (estimated for the date and region, or the temperature parameter; always in ℃); each outfit carries the temperature of its own context. The other fields are the same as in Milestone. */
+        DailyOutfitResponse: {
+            /**
+             * Format: date
+             * @description The date the outfit is for
+             * @example 2024-01-15
+             */
+            date: string;
+            /**
+             * @description Chronological age in months on the date
+             * @example 3
+             */
+            age_in_months: number;
+            /**
+             * @description Corrected age in months on the date (see Milestone)
+             * @example 3
+             */
+            corrected_age_in_months: number;
+            /**
+             * @description Estimated clothing size in cm (legacy free-form label kept for compatibility; prefer size_detail). Every size above 90cm stays "90cm+" here; size_detail tells 90-95, 100, 110 and 120 apart.
+             * @example 60-70cm
+             */
+            size: string;
+            size_detail: components["schemas"]["Size"];
+            /**
+             * Format: double
+             * @description Height projected along the baby's growth percentile on the date. Present only when height_cm or weight_kg is given.
+             * @example 61.2
+             */
+            estimated_height_cm?: number;
+            temperature: components["schemas"]["Temperature"];
+            temperature_source: components["schemas"]["TemperatureSource"];
+            estimated_temperature: components["schemas"]["Temperature"];
+            season: components["schemas"]["Season"];
+            /**
+             * @description Localized name of the season
+             * @example 冬
+             */
+            season_label: string;
+            temperature_band: components["schemas"]["TemperatureBand"];
+            /**
+             * @description Localized name of the temperature band
+             * @example 寒い
+             */
+            temperature_band_label: string;
+            /** @description Items of the outing outfit (same as Milestone.items) */
+            items: components["schemas"]["Item"][];
+            /** @description Outfits for each context, in the order indoor, outing, sleep */
+            outfits: components["schemas"]["Outfit"][];
         };
         MilestoneResponse: {
-            /** @description List of milestones from birth to 24 months */
+            /** @description List of milestones in date order (from birth to 24 months by default) */
             milestones: components["schemas"]["Milestone"][];
         };
+        TranslationResponse: {
+            /** @description Matching items, best match first (one entry per universal item) */
+            matches: components["schemas"]["ItemTranslation"][];
+        };
+        ItemTranslation: {
+            /** @example カバーオール */
+            universal_name: string;
+            /**
+             * @description Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+             * @example Short undershirt (短肌着)
+             */
+            display_name: string;
+            /** @description Short description of the item in the requested language */
+            description?: string;
+            /** @example アウター */
+            category_label: string;
+            /** @example 🧥 */
+            category_emoji: string;
+            /** @example #EDE7F6 */
+            category_color: string;
+            /**
+             * @description Shop whose name matched the query
+             * @example nishimatsuya
+             */
+            matched_shop_key: string;
+            /**
+             * @description The shop-specific name that matched the query
+             * @example プレオール
+             */
+            matched_name: string;
+            /** @description False when the query matched only after normalizing spelling variants */
+            exact: boolean;
+            /** @description Names of the same item at every other shop, in shop display order */
+            equivalents: components["schemas"]["ShopName"][];
+        };
+        ShopName: {
+            /** @example uniqlo */
+            shop_key: string;
+            /** @example ユニクロ */
+            display_name: string;
+            /** @example フライスカバーオール */
+            shop_name: string;
+        };
+        SearchResponse: {
+            /** @example こんびはだぎ */
+            query: string;
+            /**
+             * @description The query after normalization
+             * @example コンビハダギ
+             */
+            normalized_query: string;
+            results: components["schemas"]["SearchResult"][];
+        };
+        SearchResult: {
+            /** @example コンビ肌着 */
+            universal_name: string;
+            /**
+             * @description Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+             * @example Short undershirt (短肌着)
+             */
+            display_name: string;
+            /** @description Short description of the item in the requested language */
+            description?: string;
+            /** @example インナー */
+            category_label: string;
+            /** @example 👶 */
+            category_emoji: string;
+            /** @example #FFF3E0 */
+            category_color: string;
+            /**
+             * Format: double
+             * @description Similarity between 0 and 1 (1 is an exact match)
+             * @example 1
+             */
+            score: number;
+            /**
+             * @description The name, reading or category label that matched best
+             * @example こんびはだぎ
+             */
+            matched_text: string;
+            /** @description Set when the best match was a shop-specific name */
+            matched_shop_key?: string;
+            /** @description Names of the item at every shop, in shop display order */
+            shop_names: components["schemas"]["ShopName"][];
+        };
+        ItemListResponse: {
+            items: components["schemas"]["ItemDetail"][];
+        };
+        ItemDetail: {
+            /** @example 短肌着 */
+            universal_name: string;
+            /**
+             * @description Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+             * @example Short undershirt (短肌着)
+             */
+            display_name: string;
+            /** @description Short description of the item in the requested language */
+            description?: string;
+            /** @example inner */
+            category_key: string;
+            /** @example インナー */
+            category_label: string;
+            /** @example 👶 */
+            category_emoji: string;
+            /** @example #FFF3E0 */
+            category_color: string;
+            layer?: components["schemas"]["Layer"];
+            /**
+             * Format: double
+             * @description Warmth of the item in clo (0 when unknown)
+             * @example 0.1
+             */
+            warmth: number;
+            /**
+             * @description Rules that recommend the item. Empty when no rule recommends it.
+             * @example [
+             *       "newborn-inner",
+             *       "newborn-inner-hot"
+             *     ]
+             */
+            rule_ids: string[];
+            /** @description When the item is recommended: the age and temperature ranges of each rule in rule_ids, in the same order. The ranges are not merged, so an item recommended on cold and hot days (such as 帽子) has two separate temperature ranges. */
+            conditions: components["schemas"]["ItemCondition"][];
+            /** @description Names of the item at every shop, in shop display order */
+            shop_names: components["schemas"]["ShopName"][];
+        };
+        /** @description Age and temperature ranges in which one rule recommends an item */
+        ItemCondition: {
+            /** @example hat-cold */
+            rule_id: string;
+            age_range: components["schemas"]["Range"];
+            temperature_range: components["schemas"]["Range"];
+        };
+    };
+    responses: {
+        /** @description Invalid input parameters. The code tells which check failed; see Problem. */
+        BadRequest: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
+        /** @description No item matches (code item_not_found) */
+        NotFound: {
+            headers: {
+                [name: string]: unknown;
+            };
+            content: {
+                "application/problem+json": components["schemas"]["Problem"];
+            };
+        };
+    };
+    parameters: {
+        /** @description Baby's birth date (YYYY-MM-DD). Must be at most 72 months ago and at most 44 weeks ahead (an expected birth date can be used to prepare before the birth). */
+        BirthDate: string;
+        /** @description Region used to pick the climate profile. Accepts a region key (hokkaido, tohoku, kanto, hokuriku, tokai, kinki, chugoku, shikoku, kyushu, okinawa), a prefecture key (e.g. "osaka") or a prefecture name (e.g. "大阪府"). Defaults to kanto (Tokyo). */
+        Region: string;
+        /** @description Baby's due date (YYYY-MM-DD). When the baby was born before the due date, sizes and items are chosen by corrected age (months counted from the due date). */
+        DueDate: string;
+        /** @description Baby's height in cm at the last measurement (e.g., a checkup). When given, the size is chosen from the height projected along the baby's growth percentile instead of from age alone. */
+        HeightCm: number;
+        /** @description Baby's weight in kg at the last measurement. Used as a proxy for the growth percentile when height_cm is not given. */
+        WeightKg: number;
+        /** @description Sex used to pick the growth curve. The average of both curves is used when omitted. */
+        Sex: components["schemas"]["Sex"];
+        /** @description Date of the height/weight measurement (YYYY-MM-DD). Defaults to today. */
+        MeasuredOn: string;
+        /** @description Daily mean temperature to dress for, e.g. from a weather forecast. When given, it is used for every milestone instead of the temperature estimated from the monthly normals; the daily low and high keep the usual day-night range for the date. */
+        SpecifiedTemperature: number;
+        /** @description Unit of temperature. Defaults to celsius. Ignored when temperature is omitted, but an unknown unit is still rejected. */
+        TemperatureUnit: components["schemas"]["TemperatureUnit"];
+        /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+        Lang: string;
     };
-    responses: never;
-    parameters: never;
     requestBodies: never;
     headers: never;
     pathItems: never;
@@ -100,8 +781,34 @@ export interface operations {
     getMilestones: {
         parameters: {
             query: {
-                /** @description Baby's birth date (YYYY-MM-DD) */
-                birth_date: string;
+                /** @description Baby's birth date (YYYY-MM-DD). Must be at most 72 months ago and at most 44 weeks ahead (an expected birth date can be used to prepare before the birth). */
+                birth_date: components["parameters"]["BirthDate"];
+                /** @description Region used to pick the climate profile. Accepts a region key (hokkaido, tohoku, kanto, hokuriku, tokai, kinki, chugoku, shikoku, kyushu, okinawa), a prefecture key (e.g. "osaka") or a prefecture name (e.g. "大阪府"). Defaults to kanto (Tokyo). */
+                region?: components["parameters"]["Region"];
+                /** @description Baby's due date (YYYY-MM-DD). When the baby was born before the due date, sizes and items are chosen by corrected age (months counted from the due date). */
+                due_date?: components["parameters"]["DueDate"];
+                /** @description Baby's height in cm at the last measurement (e.g., a checkup). When given, the size is chosen from the height projected along the baby's growth percentile instead of from age alone. */
+                height_cm?: components["parameters"]["HeightCm"];
+                /** @description Baby's weight in kg at the last measurement. Used as a proxy for the growth percentile when height_cm is not given. */
+                weight_kg?: components["parameters"]["WeightKg"];
+                /** @description Sex used to pick the growth curve. The average of both curves is used when omitted. */
+                sex?: components["parameters"]["Sex"];
+                /** @description Date of the height/weight measurement (YYYY-MM-DD). Defaults to today. */
+                measured_on?: components["parameters"]["MeasuredOn"];
+                /** @description Daily mean temperature to dress for, e.g. from a weather forecast. When given, it is used for every milestone instead of the temperature estimated from the monthly normals; the daily low and high keep the usual day-night range for the date. */
+                temperature?: components["parameters"]["SpecifiedTemperature"];
+                /** @description Unit of temperature. Defaults to celsius. Ignored when temperature is omitted, but an unknown unit is still rejected. */
+                temperature_unit?: components["parameters"]["TemperatureUnit"];
+                /** @description Age in months of the first milestone. Defaults to 0. */
+                from_month?: number;
+                /** @description Age in months of the last milestone (inclusive). Defaults to 24. Must not be less than from_month. */
+                to_month?: number;
+                /** @description Interval in months between monthly milestones. Defaults to 1. */
+                step?: number;
+                /** @description monthly (default) returns a milestone every step months. weekly returns a milestone every week until 3 months old, when sizes change fastest, and monthly milestones after that. A weekly milestone less than a week before the next monthly one (such as week 13 right before month 3) is left out. */
+                granularity?: "monthly" | "weekly";
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
             };
             header?: never;
             path?: never;
@@ -118,13 +825,160 @@ export interface operations {
                     "application/json": components["schemas"]["MilestoneResponse"];
                 };
             };
-            /** @description Invalid input parameters */
-            400: {
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    getOutfit: {
+        parameters: {
+            query: {
+                /** @description Baby's birth date (YYYY-MM-DD). Must be at most 72 months ago and at most 44 weeks ahead (an expected birth date can be used to prepare before the birth). */
+                birth_date: components["parameters"]["BirthDate"];
+                /** @description Date to dress for (YYYY-MM-DD). Defaults to today. Must be between the birth date and 72 months after it. */
+                date?: string;
+                /** @description Region used to pick the climate profile. Accepts a region key (hokkaido, tohoku, kanto, hokuriku, tokai, kinki, chugoku, shikoku, kyushu, okinawa), a prefecture key (e.g. "osaka") or a prefecture name (e.g. "大阪府"). Defaults to kanto (Tokyo). */
+                region?: components["parameters"]["Region"];
+                /** @description Baby's due date (YYYY-MM-DD). When the baby was born before the due date, sizes and items are chosen by corrected age (months counted from the due date). */
+                due_date?: components["parameters"]["DueDate"];
+                /** @description Baby's height in cm at the last measurement (e.g., a checkup). When given, the size is chosen from the height projected along the baby's growth percentile instead of from age alone. */
+                height_cm?: components["parameters"]["HeightCm"];
+                /** @description Baby's weight in kg at the last measurement. Used as a proxy for the growth percentile when height_cm is not given. */
+                weight_kg?: components["parameters"]["WeightKg"];
+                /** @description Sex used to pick the growth curve. The average of both curves is used when omitted. */
+                sex?: components["parameters"]["Sex"];
+                /** @description Date of the height/weight measurement (YYYY-MM-DD). Defaults to today. */
+                measured_on?: components["parameters"]["MeasuredOn"];
+                /** @description Daily mean temperature to dress for, e.g. from a weather forecast. When given, it is used for every milestone instead of the temperature estimated from the monthly normals; the daily low and high keep the usual day-night range for the date. */
+                temperature?: components["parameters"]["SpecifiedTemperature"];
+                /** @description Unit of temperature. Defaults to celsius. Ignored when temperature is omitted, but an unknown unit is still rejected. */
+                temperature_unit?: components["parameters"]["TemperatureUnit"];
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description The outfit for the date */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["DailyOutfitResponse"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    listItems: {
+        parameters: {
+            query?: {
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description All catalog items in catalog order */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ItemListResponse"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+        };
+    };
+    getItem: {
+        parameters: {
+            query?: {
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
+            };
+            header?: never;
+            path: {
+                /** @description Universal (Japanese) item name */
+                universal_name: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description The catalog item */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ItemDetail"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    translateItem: {
+        parameters: {
+            query: {
+                /** @description Shop-specific item name to translate */
+                name: string;
+                /** @description Shop key the name comes from. All shops are searched when omitted. */
+                shop?: string;
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Matching universal items */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": components["schemas"]["TranslationResponse"];
+                };
+            };
+            400: components["responses"]["BadRequest"];
+            404: components["responses"]["NotFound"];
+        };
+    };
+    searchItems: {
+        parameters: {
+            query: {
+                /** @description Search text. A single-character query only returns items with exactly that name. */
+                q: string;
+                /** @description Maximum number of results */
+                limit?: number;
+                /** @description Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store. */
+                lang?: components["parameters"]["Lang"];
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Matching items, best match first */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["SearchResponse"];
+                };
             };
+            400: components["responses"]["BadRequest"];
         };
     };
 }
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
 	"github.com/kenji/baby-wear-translator/backend/internal/handler"
 )
 
@@ -26,14 +31,80 @@ func SetupRouter() *gin.Engine {
 	r.Use(cors.New(config))
 
 	// ハンドラーの初期化
-	h := handler.NewRecommendHandler()
+	h := handler.NewRecommendHandler(handlerOptions()...)
 
-	// oapi-codegen で生成された RegisterHandlers を使用してルートを登録
-	handler.RegisterHandlers(r, h)
+	// oapi-codegen で生成された RegisterHandlersWithOptions を使用してルートを登録（エラーは application/problem+json で返す）
+	handler.Register(r, h)
 
 	return r
 }
 
+// handlerOptions は環境変数からハンドラーの設定を組み立てます
+func handlerOptions() []handler.Option {
+	var opts []handler.Option
+
+	// 予報ファイル（CSV/JSON）が指定されていれば、平年値より優先して使う
+	if path := os.Getenv("FORECAST_FILE"); path != "" {
+		p, err := domain.LoadForecastFile(path, domain.ClimatologyProvider{})
+		if err != nil {
+			log.Fatal("Failed to load forecast file: ", err)
+		}
+		log.Printf("Using forecast file: %s", path)
+		opts = append(opts, handler.WithTemperatureProvider(p))
+	}
+
+	// 推薦ルールファイルが指定されていれば、組み込みのデフォルトルールの代わりに使う
+	rules := domain.DefaultRuleSet()
+	if path := os.Getenv("RULES_FILE"); path != "" {
+		rs, err := domain.LoadRuleSet(path)
+		if err != nil {
+			log.Fatal("Failed to load rule file: ", err)
+		}
+		log.Printf("Using rule file: %s", path)
+		rules = rs
+		opts = append(opts, handler.WithRuleSet(rs))
+	}
+
+	// カタログファイルが指定されていれば、組み込みのカタログの代わりに使い、変更を監視する
+	if path := os.Getenv("CATALOG_FILE"); path != "" {
+		store, err := domain.OpenCatalogStore(path, rules.ValidateItems)
+		if err != nil {
+			log.Fatal("Failed to load catalog file: ", err)
+		}
+		log.Printf("Using catalog file: %s", path)
+		watchCatalog(store, path)
+		opts = append(opts, handler.WithCatalogStore(store))
+	} else if err := rules.ValidateItems(domain.DefaultCatalog()); err != nil {
+		log.Fatal("Rule set does not match the catalog: ", err)
+	}
+
+	return opts
+}
+
+// catalogPollInterval はカタログファイルの更新を確認する間隔です
+const catalogPollInterval = 5 * time.Second
+
+// watchCatalog は SIGHUP を受け取ったとき、またはファイルが更新されたときにカタログを読み込み直します
+func watchCatalog(store *domain.CatalogStore, path string) {
+	logReload := func(err error) {
+		if err != nil {
+			log.Printf("Failed to reload catalog file (keeping the previous catalog): %v", err)
+			return
+		}
+		log.Printf("Reloaded catalog file: %s", path)
+	}
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			logReload(store.Reload())
+		}
+	}()
+
+	go store.Watch(context.Background(), catalogPollInterval, logReload)
+}
+
 func main() {
 	r := SetupRouter()
 
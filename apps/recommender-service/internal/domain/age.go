@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -23,3 +25,64 @@ func CalculateAgeInMonths(birthDate, currentDate time.Time) int {
 
 	return totalMonths
 }
+
+// 出産予定日として受け付ける範囲です。
+// 妊娠22週未満での出生や、予定日を大きく過ぎた出生は想定しません。
+const (
+	maxWeeksBeforeDueDate = 18 // 40週 - 22週
+	maxWeeksAfterDueDate  = 4  // 44週
+)
+
+// maxWeeksUntilBirth は生まれる前に計画できる期間（今日から生年月日まで）の上限です。
+// 妊娠が分かってから（予定日の40週前）予定日を maxWeeksAfterDueDate 週過ぎての出生までを受け付けます。
+const maxWeeksUntilBirth = 40 + maxWeeksAfterDueDate
+
+// ErrBirthDateOutOfRange は生年月日が今日に対して扱える範囲にない場合のエラーです。
+var ErrBirthDateOutOfRange = errors.New("birth date is out of range")
+
+// ValidateBirthDate は生年月日が、今日の月齢が MaxMilestoneMonth ヶ月（サポートする月齢の上限）以下の子から、
+// 今日から maxWeeksUntilBirth 週以内に生まれる予定の子までの範囲にあるかを確認します。
+// 生まれる前の日付は、出産予定日を生年月日として服を準備する場合に使います。
+func ValidateBirthDate(birthDate, today time.Time) error {
+	earliest := today.AddDate(0, -MaxMilestoneMonth, 0)
+	latest := today.AddDate(0, 0, 7*maxWeeksUntilBirth)
+	if birthDate.Before(earliest) || birthDate.After(latest) {
+		return fmt.Errorf("%w: birth date must be between %s and %s",
+			ErrBirthDateOutOfRange, earliest.Format(time.DateOnly), latest.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// ErrDueDateOutOfRange は出産予定日が生年月日に対して現実的でない場合のエラーです。
+var ErrDueDateOutOfRange = errors.New("due date is out of range for the birth date")
+
+// Age は暦上の月齢と修正月齢の組です。
+type Age struct {
+	Chronological int // 生年月日を基準にした月齢
+	Corrected     int // 出産予定日を基準にした修正月齢（早産でなければ Chronological と同じ）
+}
+
+// ValidateDueDate は出産予定日が生年月日に対して受け付け可能な範囲にあるかを確認します。
+func ValidateDueDate(birthDate, dueDate time.Time) error {
+	earliest := birthDate.AddDate(0, 0, -7*maxWeeksAfterDueDate)
+	latest := birthDate.AddDate(0, 0, 7*maxWeeksBeforeDueDate)
+	if dueDate.Before(earliest) || dueDate.After(latest) {
+		return fmt.Errorf("%w: due date must be between %s and %s",
+			ErrDueDateOutOfRange, earliest.Format(time.DateOnly), latest.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// CalculateAge は暦上の月齢と修正月齢を算出します。
+// 修正月齢は早産児（出産予定日より前に生まれた子）の発達の目安で、出産予定日から数えた月齢です。
+// dueDate が nil の場合や、予定日以降に生まれた場合は暦上の月齢と同じになります。
+func CalculateAge(birthDate time.Time, dueDate *time.Time, currentDate time.Time) Age {
+	chronological := CalculateAgeInMonths(birthDate, currentDate)
+	if dueDate == nil || !dueDate.After(birthDate) {
+		return Age{Chronological: chronological, Corrected: chronological}
+	}
+	return Age{
+		Chronological: chronological,
+		Corrected:     CalculateAgeInMonths(*dueDate, currentDate),
+	}
+}
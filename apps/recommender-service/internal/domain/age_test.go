@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -147,3 +148,119 @@ func FuzzCalculateAgeInMonths(f *testing.F) {
 		}
 	})
 }
+
+func TestCalculateAge(t *testing.T) {
+	tests := []struct {
+		name              string
+		birthDate         string
+		dueDate           string // 空文字は予定日なし
+		today             string
+		wantChronological int
+		wantCorrected     int
+	}{
+		{
+			name:              "予定日なし: 修正月齢は暦上の月齢と同じ",
+			birthDate:         "2025-10-01",
+			today:             "2026-01-01",
+			wantChronological: 3,
+			wantCorrected:     3,
+		},
+		{
+			name:              "2ヶ月早く生まれた: 修正月齢は2ヶ月少ない",
+			birthDate:         "2025-10-01",
+			dueDate:           "2025-12-01",
+			today:             "2026-04-01",
+			wantChronological: 6,
+			wantCorrected:     4,
+		},
+		{
+			name:              "予定日前: 修正月齢は0",
+			birthDate:         "2025-10-01",
+			dueDate:           "2025-12-01",
+			today:             "2025-11-15",
+			wantChronological: 1,
+			wantCorrected:     0,
+		},
+		{
+			name:              "予定日より後に生まれた: 暦上の月齢を使う",
+			birthDate:         "2025-10-10",
+			dueDate:           "2025-10-01",
+			today:             "2026-01-10",
+			wantChronological: 3,
+			wantCorrected:     3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var due *time.Time
+			if tt.dueDate != "" {
+				d := parseDate(t, tt.dueDate)
+				due = &d
+			}
+			got := domain.CalculateAge(parseDate(t, tt.birthDate), due, parseDate(t, tt.today))
+			if got.Chronological != tt.wantChronological || got.Corrected != tt.wantCorrected {
+				t.Errorf("CalculateAge() = %+v, want {Chronological:%d Corrected:%d}",
+					got, tt.wantChronological, tt.wantCorrected)
+			}
+		})
+	}
+}
+
+func TestValidateDueDate(t *testing.T) {
+	birth := parseDate(t, "2025-10-01")
+
+	tests := []struct {
+		name    string
+		dueDate string
+		wantErr bool
+	}{
+		{name: "予定日ちょうど", dueDate: "2025-10-01"},
+		{name: "8週早い", dueDate: "2025-11-26"},
+		{name: "2週遅い", dueDate: "2025-09-17"},
+		{name: "30週早い（ありえない）", dueDate: "2026-04-29", wantErr: true},
+		{name: "10週遅い（ありえない）", dueDate: "2025-07-23", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.ValidateDueDate(birth, parseDate(t, tt.dueDate))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDueDate(%s) error = %v, wantErr %v", tt.dueDate, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrDueDateOutOfRange) {
+				t.Errorf("error should wrap ErrDueDateOutOfRange: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateBirthDate(t *testing.T) {
+	today := parseDate(t, "2026-10-18")
+
+	tests := []struct {
+		name      string
+		birthDate string
+		wantErr   bool
+	}{
+		{name: "今日", birthDate: "2026-10-18"},
+		{name: "6歳ちょうど", birthDate: "2020-10-18"},
+		{name: "6歳を過ぎている", birthDate: "2020-10-17", wantErr: true},
+		{name: "1900年", birthDate: "1900-01-01", wantErr: true},
+		{name: "44週後に生まれる予定", birthDate: "2027-08-22"},
+		{name: "44週より先", birthDate: "2027-08-23", wantErr: true},
+		{name: "2099年", birthDate: "2099-01-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := domain.ValidateBirthDate(parseDate(t, tt.birthDate), today)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBirthDate(%s) error = %v, wantErr %v", tt.birthDate, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrBirthDateOutOfRange) {
+				t.Errorf("error should wrap ErrBirthDateOutOfRange: %v", err)
+			}
+		})
+	}
+}
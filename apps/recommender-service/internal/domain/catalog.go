@@ -0,0 +1,442 @@
+package domain
+
+import (
+	"bytes"
+	"cmp"
+	_ "embed"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed catalog/default.yaml
+var defaultCatalogYAML []byte
+
+// CatalogVersion は読み込みに対応しているカタログファイルのバージョンです。
+// バージョン 2 で shops がショップ ID の一覧からショップ情報の一覧に変わりました。
+// バージョン 1 のファイルは読み込むときにバージョン 2 の形式に移行します。
+const CatalogVersion = 2
+
+// brandColorPattern はブランドカラー（#RRGGBB）の形式です。
+var brandColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Shop はショップの表示用の情報です。
+type Shop struct {
+	Key         string `yaml:"key"`
+	DisplayName string `yaml:"display_name"`
+	LogoURL     string `yaml:"logo_url"`
+	BrandColor  string `yaml:"brand_color"`
+	Priority    int    `yaml:"priority"` // 表示順（小さいほど先）
+}
+
+// Category はアイテムのカテゴリー情報です
+type Category struct {
+	Label  string        `yaml:"label"`
+	Labels LocalizedText `yaml:"labels"` // 日本語以外の表示名（任意）
+	Emoji  string        `yaml:"emoji"`
+	Color  string        `yaml:"color"`
+}
+
+// LocalLabel は lang での表示名を返します。翻訳がない場合は日本語の Label を返します。
+func (c Category) LocalLabel(lang Language) string {
+	if s, ok := c.Labels[lang]; ok && s != "" {
+		return s
+	}
+	return c.Label
+}
+
+// CatalogItem はカタログの1アイテム（汎用名と、ショップごとの固有名・商品）です。
+type CatalogItem struct {
+	Name         string            `yaml:"name"`         // 汎用名（universal_name）
+	Names        LocalizedText     `yaml:"names"`        // 汎用名の日本語以外の表記（任意）
+	Descriptions LocalizedText     `yaml:"descriptions"` // 言語ごとの説明（任意）
+	Category     string            `yaml:"category"`     // Catalog.Categories のキー（レイヤーがあるアイテムではレイヤーのキー）
+	Layer        Layer             `yaml:"layer"`        // 重ね着のレイヤー（任意）
+	Warmth       float64           `yaml:"warmth"`       // 暖かさ（clo 値の目安）
+	Readings     []string          `yaml:"readings"`     // 汎用名・ショップ固有名の読み（検索用、任意）
+	ShopNames    map[string]string `yaml:"shop_names"`   // shop_id -> shop_specific_name
+	Sizes        map[string][]int  `yaml:"sizes"`        // shop_id -> 取り扱いサイズ（cm 表記、任意）
+	Products     []Product         `yaml:"products"`     // 購入できる具体的な商品（任意）
+}
+
+// DisplayName は lang での表示名を返します。
+// 日本語以外では、お店で目にする日本語の汎用名を括弧で添えます（例: "Short undershirt (短肌着)"）。
+// 翻訳がない場合は汎用名を返します。
+func (i CatalogItem) DisplayName(lang Language) string {
+	if lang == LangJA {
+		return i.Name
+	}
+	if s, ok := i.Names[lang]; ok && s != "" {
+		return fmt.Sprintf("%s (%s)", s, i.Name)
+	}
+	return i.Name
+}
+
+// Description は lang での説明を返します。翻訳がない場合は日本語の説明を返します。
+func (i CatalogItem) Description(lang Language) string {
+	return i.Descriptions.In(lang)
+}
+
+// Product はあるショップで購入できる具体的な商品です。
+type Product struct {
+	Shop     string     `yaml:"shop"`     // Catalog.Shops のキー
+	SKU      string     `yaml:"sku"`      // ショップの商品番号（任意）
+	JAN      string     `yaml:"jan"`      // JAN コード（任意、8桁または13桁）
+	URL      string     `yaml:"url"`      // 商品ページの URL
+	Price    PriceRange `yaml:"price"`    // 価格帯（税込・円）
+	Sizes    []int      `yaml:"sizes"`    // 取り扱いサイズ（cm 表記）
+	Material string     `yaml:"material"` // 素材（任意）
+}
+
+// PriceRange は税込の価格帯（円）です。サイズや色で価格が異なる商品は幅を持ちます。
+type PriceRange struct {
+	Min int `yaml:"min"`
+	Max int `yaml:"max"`
+}
+
+// FitsSize は商品がサイズ s の範囲のいずれかのサイズで販売されているかどうかを返します。
+func (p Product) FitsSize(s Size) bool {
+	return s.Includes(p.Sizes)
+}
+
+// Catalog は汎用名とショップ固有名の対応表（マスターデータ）です。
+type Catalog struct {
+	Version    int                 `yaml:"version"`
+	Shops      []Shop              `yaml:"shops"` // 表示順（Priority の昇順）に並べ替え済み
+	Categories map[string]Category `yaml:"categories"`
+	Items      []CatalogItem       `yaml:"items"`
+
+	index  map[string]int       // 汎用名 -> Items の添字
+	names  map[string][]nameRef // 正規化したショップ固有名 -> アイテム（逆引き用）
+	search []searchEntry        // 検索対象の名前（正規化済み）
+}
+
+// defaultCatalog は埋め込みのデフォルトカタログを一度だけ読み込みます。
+var defaultCatalog = sync.OnceValue(func() *Catalog {
+	c, err := ParseCatalog(defaultCatalogYAML)
+	if err != nil {
+		panic(fmt.Sprintf("domain: invalid default catalog: %v", err))
+	}
+	return c
+})
+
+// DefaultCatalog は組み込みのデフォルトカタログ（catalog/default.yaml）を返します。
+func DefaultCatalog() *Catalog {
+	return defaultCatalog()
+}
+
+// LoadCatalog はカタログファイル（YAML または JSON）を読み込み、検証して返します。
+func LoadCatalog(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read catalog file: %w", err)
+	}
+	c, err := ParseCatalog(data)
+	if err != nil {
+		return nil, fmt.Errorf("catalog file %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// ParseCatalog はカタログ定義（YAML または JSON）を解析し、検証して返します。
+func ParseCatalog(data []byte) (*Catalog, error) {
+	c, err := decodeCatalog(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse catalog: %w", err)
+	}
+	c.categorizeByLayer()
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 同じ Priority のショップはキーの順に並べて、表示順を常に一定にする
+	slices.SortStableFunc(c.Shops, func(a, b Shop) int {
+		return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(a.Key, b.Key))
+	})
+
+	c.index = make(map[string]int, len(c.Items))
+	for i, item := range c.Items {
+		c.index[item.Name] = i
+	}
+	c.buildNameIndex()
+	c.buildSearchIndex()
+	return c, nil
+}
+
+// catalogV1 はバージョン 1 のカタログファイルの形式です。shops はショップ ID の一覧（表示順）でした。
+type catalogV1 struct {
+	Version    int                 `yaml:"version"`
+	Shops      []string            `yaml:"shops"`
+	Categories map[string]Category `yaml:"categories"`
+	Items      []CatalogItem       `yaml:"items"`
+}
+
+// decodeCatalog はカタログ定義を読み込みます。バージョン 1 のファイルは現在のバージョンの形式に移行します。
+func decodeCatalog(data []byte) (*Catalog, error) {
+	var header struct {
+		Version int `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if header.Version != 1 {
+		var c Catalog
+		if err := dec.Decode(&c); err != nil {
+			return nil, err
+		}
+		return &c, nil
+	}
+
+	var v1 catalogV1
+	if err := dec.Decode(&v1); err != nil {
+		return nil, fmt.Errorf("version 1: %w", err)
+	}
+	return v1.migrate(), nil
+}
+
+// migrate はバージョン 1 のカタログを現在のバージョンに移行します。
+// ショップの表示名にはショップ ID を使い、表示順は shops に並んでいた順にします。
+func (v1 catalogV1) migrate() *Catalog {
+	shops := make([]Shop, len(v1.Shops))
+	for i, key := range v1.Shops {
+		shops[i] = Shop{Key: key, DisplayName: key, Priority: i}
+	}
+	return &Catalog{Version: CatalogVersion, Shops: shops, Categories: v1.Categories, Items: v1.Items}
+}
+
+// categorizeByLayer はカテゴリーを省略したアイテムに、レイヤーと同じキーのカテゴリーを設定します。
+// レイヤーを分類の元にして、表示用のカテゴリーとコーディネートのレイヤーが食い違わないようにします。
+func (c *Catalog) categorizeByLayer() {
+	for i, item := range c.Items {
+		if item.Category == "" && item.Layer != "" {
+			c.Items[i].Category = string(item.Layer)
+		}
+	}
+}
+
+// Validate はカタログの整合性を検証します。
+//   - 対応しているバージョンであること
+//   - ショップ ID・汎用名が空でなく、一意であること
+//   - ショップの表示名があり、ブランドカラーが #RRGGBB 形式であること
+//   - すべてのアイテムに全ショップの固有名があり、空文字でないこと
+//   - アイテムのカテゴリーが定義され、レイヤーがあるアイテムではレイヤーと同じキーであること
+//   - アイテムのレイヤーが既知のもので、暖かさが 0 以上であること
+//   - 翻訳の言語が対応している言語であること
+//   - 取り扱いサイズのショップが定義され、サイズが正の値であること
+//   - 商品のショップが定義され、URL・価格帯・サイズ・JAN コードが正しいこと
+func (c *Catalog) Validate() error {
+	var errs []error
+	if c.Version != CatalogVersion {
+		errs = append(errs, fmt.Errorf("unsupported catalog version %d (want %d)", c.Version, CatalogVersion))
+	}
+	if len(c.Shops) == 0 {
+		errs = append(errs, errors.New("catalog has no shops"))
+	}
+	if len(c.Items) == 0 {
+		errs = append(errs, errors.New("catalog has no items"))
+	}
+
+	shops := map[string]bool{}
+	for _, shop := range c.Shops {
+		if shop.Key == "" {
+			errs = append(errs, errors.New("shop key is empty"))
+		} else if shops[shop.Key] {
+			errs = append(errs, fmt.Errorf("duplicate shop key %q", shop.Key))
+		}
+		shops[shop.Key] = true
+
+		if shop.DisplayName == "" {
+			errs = append(errs, fmt.Errorf("shop %q: display_name is empty", shop.Key))
+		}
+		if shop.BrandColor != "" && !brandColorPattern.MatchString(shop.BrandColor) {
+			errs = append(errs, fmt.Errorf("shop %q: brand_color %q must be #RRGGBB", shop.Key, shop.BrandColor))
+		}
+	}
+
+	for key, cat := range c.Categories {
+		errs = append(errs, validateLanguages(fmt.Sprintf("category %q: labels", key), cat.Labels)...)
+	}
+
+	names := map[string]bool{}
+	for _, item := range c.Items {
+		if item.Name == "" {
+			errs = append(errs, errors.New("item name is empty"))
+		} else if names[item.Name] {
+			errs = append(errs, fmt.Errorf("duplicate item %q", item.Name))
+		}
+		names[item.Name] = true
+
+		if _, ok := c.Categories[item.Category]; !ok {
+			errs = append(errs, fmt.Errorf("item %q: unknown category %q", item.Name, item.Category))
+		}
+		if item.Layer != "" {
+			if _, err := ParseLayer(string(item.Layer)); err != nil {
+				errs = append(errs, fmt.Errorf("item %q: %w", item.Name, err))
+			} else if item.Category != string(item.Layer) {
+				errs = append(errs, fmt.Errorf("item %q: category %q does not match layer %q (omit category to use the layer)", item.Name, item.Category, item.Layer))
+			}
+		}
+		if item.Warmth < 0 {
+			errs = append(errs, fmt.Errorf("item %q: warmth must not be negative", item.Name))
+		}
+		errs = append(errs, validateLanguages(fmt.Sprintf("item %q: names", item.Name), item.Names)...)
+		errs = append(errs, validateLanguages(fmt.Sprintf("item %q: descriptions", item.Name), item.Descriptions)...)
+		for _, shop := range c.Shops {
+			if _, ok := item.ShopNames[shop.Key]; !ok {
+				errs = append(errs, fmt.Errorf("item %q: missing name for shop %q", item.Name, shop.Key))
+			}
+		}
+		for shop, name := range item.ShopNames {
+			if !shops[shop] {
+				errs = append(errs, fmt.Errorf("item %q: unknown shop %q", item.Name, shop))
+			}
+			if name == "" {
+				errs = append(errs, fmt.Errorf("item %q: name for shop %q is empty", item.Name, shop))
+			}
+		}
+		for shop, sizes := range item.Sizes {
+			if !shops[shop] {
+				errs = append(errs, fmt.Errorf("item %q: sizes: unknown shop %q", item.Name, shop))
+			}
+			if len(sizes) == 0 || slices.ContainsFunc(sizes, func(cm int) bool { return cm <= 0 }) {
+				errs = append(errs, fmt.Errorf("item %q: sizes for shop %q must be positive and not empty", item.Name, shop))
+			}
+		}
+		for i, p := range item.Products {
+			if !shops[p.Shop] {
+				errs = append(errs, fmt.Errorf("item %q: product %d: unknown shop %q", item.Name, i, p.Shop))
+			}
+			if err := p.validate(); err != nil {
+				errs = append(errs, fmt.Errorf("item %q: product %d: %w", item.Name, i, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateLanguages は翻訳の言語が対応している言語であることを確認します。
+func validateLanguages(field string, text LocalizedText) []error {
+	var errs []error
+	for lang := range text {
+		if !slices.Contains(SupportedLanguages, lang) {
+			errs = append(errs, fmt.Errorf("%s: unsupported language %q", field, lang))
+		}
+	}
+	return errs
+}
+
+// validate は商品の URL・価格帯・サイズ・JAN コードを検証します。
+func (p Product) validate() error {
+	var errs []error
+	if u, err := url.Parse(p.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = append(errs, fmt.Errorf("url %q must be an absolute http(s) URL", p.URL))
+	}
+	if p.Price.Min <= 0 || p.Price.Min > p.Price.Max {
+		errs = append(errs, fmt.Errorf("price must satisfy 0 < min <= max, got %d-%d", p.Price.Min, p.Price.Max))
+	}
+	if len(p.Sizes) == 0 {
+		errs = append(errs, errors.New("sizes is empty"))
+	}
+	for _, cm := range p.Sizes {
+		if cm <= 0 {
+			errs = append(errs, fmt.Errorf("invalid size %d", cm))
+		}
+	}
+	if p.JAN != "" && !validJAN(p.JAN) {
+		errs = append(errs, fmt.Errorf("invalid jan %q", p.JAN))
+	}
+	return errors.Join(errs...)
+}
+
+// validJAN は JAN コード（8桁または13桁）の形式とチェックデジットを確認します。
+func validJAN(code string) bool {
+	if len(code) != 8 && len(code) != 13 {
+		return false
+	}
+	sum := 0
+	for i := range len(code) - 1 {
+		d := code[i]
+		if d < '0' || d > '9' {
+			return false
+		}
+		// 右端（チェックデジットの左隣）から数えて奇数桁を3倍する
+		if (len(code)-1-i)%2 == 1 {
+			sum += int(d-'0') * 3
+		} else {
+			sum += int(d - '0')
+		}
+	}
+	check := code[len(code)-1]
+	return check >= '0' && check <= '9' && int(check-'0') == (10-sum%10)%10
+}
+
+// Shop はキーに対応するショップの情報を返します。
+func (c *Catalog) Shop(key string) (Shop, bool) {
+	i := c.shopIndex(key)
+	if i < 0 {
+		return Shop{}, false
+	}
+	return c.Shops[i], true
+}
+
+// shopIndex はショップの表示順（Shops の添字）を返します。見つからない場合は -1 を返します。
+func (c *Catalog) shopIndex(key string) int {
+	return slices.IndexFunc(c.Shops, func(s Shop) bool { return s.Key == key })
+}
+
+// Item は汎用名に対応するアイテムを返します。
+func (c *Catalog) Item(name string) (CatalogItem, bool) {
+	i, ok := c.index[name]
+	if !ok {
+		return CatalogItem{}, false
+	}
+	return c.Items[i], true
+}
+
+// Available は汎用名のアイテムがショップ shop でサイズ size の範囲で取り扱われているかどうかを返します。
+// 取り扱いサイズが登録されていない場合は、すべてのサイズを扱っているものとみなします。
+func (c *Catalog) Available(name, shop string, size Size) bool {
+	item, ok := c.Item(name)
+	if !ok {
+		return false
+	}
+	sizes, ok := item.Sizes[shop]
+	if !ok {
+		return true
+	}
+	return size.Includes(sizes)
+}
+
+// Offers は汎用名のアイテムについて、ショップ shop で販売されているサイズ size の商品を返します。
+func (c *Catalog) Offers(name, shop string, size Size) []Product {
+	item, ok := c.Item(name)
+	if !ok {
+		return nil
+	}
+	var offers []Product
+	for _, p := range item.Products {
+		if p.Shop == shop && p.FitsSize(size) {
+			offers = append(offers, p)
+		}
+	}
+	return offers
+}
+
+// CategoryOf は汎用名に対応するアイテムのカテゴリー情報を返します。
+func (c *Catalog) CategoryOf(name string) (Category, bool) {
+	item, ok := c.Item(name)
+	if !ok {
+		return Category{}, false
+	}
+	cat, ok := c.Categories[item.Category]
+	return cat, ok
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CatalogStore はカタログファイルを読み込み、サーバーを止めずに差し替えられるようにする入れ物です。
+// 読み込みに失敗した場合は直前のカタログを使い続けます。
+type CatalogStore struct {
+	path  string
+	check func(*Catalog) error
+
+	current atomic.Pointer[Catalog]
+
+	mu      sync.Mutex // Reload を直列化する
+	modTime time.Time  // 最後に読み込んだファイルの更新日時
+}
+
+// OpenCatalogStore はカタログファイルを読み込んで CatalogStore を作成します。
+// check が nil でなければ、読み込みのたびにカタログを追加で検証します（ルールとの整合性など）。
+func OpenCatalogStore(path string, check func(*Catalog) error) (*CatalogStore, error) {
+	s := &CatalogStore{path: path, check: check}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Catalog は現在のカタログを返します。
+func (s *CatalogStore) Catalog() *Catalog {
+	return s.current.Load()
+}
+
+// Reload はカタログファイルを読み込み直します。
+// 読み込み・検証に失敗した場合はエラーを返し、現在のカタログはそのまま残します。
+func (s *CatalogStore) Reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("stat catalog file: %w", err)
+	}
+	c, err := LoadCatalog(s.path)
+	if err != nil {
+		return err
+	}
+	if s.check != nil {
+		if err := s.check(c); err != nil {
+			return fmt.Errorf("catalog file %s: %w", s.path, err)
+		}
+	}
+
+	s.current.Store(c)
+	s.modTime = info.ModTime()
+	return nil
+}
+
+// Watch は interval ごとにカタログファイルの更新日時を確認し、変更されていれば読み込み直します。
+// notify が nil でなければ、読み込み直すたびにその結果（成功時は nil）を渡します。
+// ctx がキャンセルされるまで戻りません。
+func (s *CatalogStore) Watch(ctx context.Context, interval time.Duration, notify func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !s.changed() {
+			continue
+		}
+		err := s.Reload()
+		if err != nil {
+			// 壊れたファイルを毎回読み直さないよう、次の変更まで待つ
+			s.markSeen()
+		}
+		if notify != nil {
+			notify(err)
+		}
+	}
+}
+
+// changed はカタログファイルが最後の読み込み以降に更新されたかどうかを返します。
+func (s *CatalogStore) changed() bool {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !info.ModTime().Equal(s.modTime)
+}
+
+// markSeen は現在のファイルの更新日時を読み込み済みとして記録します。
+func (s *CatalogStore) markSeen() {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.modTime = info.ModTime()
+}
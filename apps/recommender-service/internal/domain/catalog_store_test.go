@@ -0,0 +1,109 @@
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+const storeCatalogYAML = `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: %s }
+`
+
+func writeCatalog(t *testing.T, path, shopName string) {
+	t.Helper()
+	if err := os.WriteFile(path, fmt.Appendf(nil, storeCatalogYAML, shopName), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func shopName(s *domain.CatalogStore) string {
+	item, _ := s.Catalog().Item("短肌着")
+	return item.ShopNames["nishimatsuya"]
+}
+
+func TestCatalogStore_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	writeCatalog(t, path, "短肌着")
+
+	store, err := domain.OpenCatalogStore(path, nil)
+	if err != nil {
+		t.Fatalf("OpenCatalogStore: %v", err)
+	}
+
+	writeCatalog(t, path, "新しい短肌着")
+	if err := store.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if got := shopName(store); got != "新しい短肌着" {
+		t.Errorf("after reload = %q, want 新しい短肌着", got)
+	}
+
+	// 壊れたファイルは拒否し、直前のカタログを使い続ける
+	if err := os.WriteFile(path, []byte("version: 2\nshops: ["), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Reload(); err == nil {
+		t.Error("Reload should fail for a broken file")
+	}
+	if got := shopName(store); got != "新しい短肌着" {
+		t.Errorf("after failed reload = %q, want the previous catalog", got)
+	}
+}
+
+// TestCatalogStore_Check は追加の検証に通らないカタログを読み込まないことを確認します。
+func TestCatalogStore_Check(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	writeCatalog(t, path, "短肌着")
+
+	if _, err := domain.OpenCatalogStore(path, domain.DefaultRuleSet().ValidateItems); err == nil {
+		t.Error("OpenCatalogStore should fail when the rule set refers to missing items")
+	}
+}
+
+func TestCatalogStore_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	writeCatalog(t, path, "短肌着")
+
+	store, err := domain.OpenCatalogStore(path, nil)
+	if err != nil {
+		t.Fatalf("OpenCatalogStore: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloaded := make(chan error, 1)
+	go store.Watch(ctx, 10*time.Millisecond, func(err error) { reloaded <- err })
+
+	writeCatalog(t, path, "新しい短肌着")
+	// 更新日時の分解能が粗いファイルシステムでも変更として検出されるようにする
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-reloaded:
+		if err != nil {
+			t.Fatalf("reload failed: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("catalog was not reloaded after the file changed")
+	}
+	if got := shopName(store); got != "新しい短肌着" {
+		t.Errorf("after watch = %q, want 新しい短肌着", got)
+	}
+}
@@ -0,0 +1,527 @@
+package domain_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+var expectedShops = []string{"nishimatsuya", "uniqlo", "akachan_honpo"}
+
+// TestDefaultCatalog_AllUniversalNamesHaveAllShops は、
+// すべての汎用名について全ショップの固有名が定義されていることを確認します。
+func TestDefaultCatalog_AllUniversalNamesHaveAllShops(t *testing.T) {
+	for _, item := range domain.DefaultCatalog().Items {
+		for _, shopID := range expectedShops {
+			if _, ok := item.ShopNames[shopID]; !ok {
+				t.Errorf("catalog item %q は shopID=%q のエントリが存在しません", item.Name, shopID)
+			}
+		}
+	}
+}
+
+// TestDefaultCatalog_NoEmptyName は、すべての固有名が空文字でないことを確認します。
+func TestDefaultCatalog_NoEmptyName(t *testing.T) {
+	for _, item := range domain.DefaultCatalog().Items {
+		for shopID, specificName := range item.ShopNames {
+			if specificName == "" {
+				t.Errorf("catalog item %q の shopID=%q の固有名が空文字です", item.Name, shopID)
+			}
+		}
+	}
+}
+
+// TestDefaultCatalog_RecommendedItemsAreMapped は、
+// Recommend 関数が返す可能性のある全 universal_name について
+// カタログにアイテムとカテゴリーが存在することを確認します。
+func TestDefaultCatalog_RecommendedItemsAreMapped(t *testing.T) {
+	// Recommend が返しうるすべての universal_name を収集する
+	// （月齢 0〜72 × 代表的な気温帯を網羅）
+	temps := []float64{-10, 5, 10, 12, 15, 18, 20, 22, 25, 30}
+	ages := []int{0, 1, 2, 3, 4, 6, 12, 18, 24, 36, 48, 72}
+
+	seen := map[string]bool{}
+	for _, age := range ages {
+		for _, temp := range temps {
+			for _, item := range domain.Recommend(age, temp) {
+				seen[item] = true
+			}
+		}
+	}
+
+	catalog := domain.DefaultCatalog()
+	for uname := range seen {
+		if _, ok := catalog.Item(uname); !ok {
+			t.Errorf("Recommend が返す %q に対応するカタログのエントリが存在しません", uname)
+		}
+		if _, ok := catalog.CategoryOf(uname); !ok {
+			t.Errorf("Recommend が返す %q に対応するカテゴリーが存在しません", uname)
+		}
+	}
+
+	// カタログのアイテムはすべてどこかの月齢・気温で推薦される
+	for _, item := range catalog.Items {
+		if !seen[item.Name] {
+			t.Errorf("catalog item %q は Recommend でどの月齢・気温でも推薦されません", item.Name)
+		}
+	}
+
+	if err := domain.DefaultRuleSet().ValidateItems(catalog); err != nil {
+		t.Errorf("default rule set refers to items missing from the catalog: %v", err)
+	}
+}
+
+// TestDefaultCatalog_MilestoneItemsHaveSizes は、マイルストーンを算出できる月齢（0〜72ヶ月）の
+// すべてのコーディネートのアイテムについて、その月齢のサイズを扱うショップがあることを確認します。
+func TestDefaultCatalog_MilestoneItemsHaveSizes(t *testing.T) {
+	temps := []float64{-10, 5, 10, 12, 15, 18, 20, 22, 25, 30}
+	catalog := domain.DefaultCatalog()
+	rules := domain.DefaultRuleSet()
+
+	for age := 0; age <= domain.MaxMilestoneMonth; age++ {
+		size := domain.EstimateSize(age)
+		for _, temp := range temps {
+			outdoor := domain.TemperatureEstimate{Mean: temp, Min: temp, Max: temp}
+			for _, o := range rules.BuildOutfits(catalog, age, size, outdoor) {
+				for _, item := range o.Items {
+					available := slices.ContainsFunc(catalog.Shops, func(shop domain.Shop) bool {
+						return catalog.Available(item.Item, shop.Key, size)
+					})
+					if !available {
+						t.Errorf("%dヶ月 %v℃ %s: %q はサイズ %s を扱うショップがありません", age, temp, o.Context, item.Item, size.Label)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestDefaultCatalog_LayerAndWarmth は、すべてのアイテムにレイヤーと暖かさが設定されていることを確認します。
+func TestDefaultCatalog_LayerAndWarmth(t *testing.T) {
+	for _, item := range domain.DefaultCatalog().Items {
+		if item.Layer == "" {
+			t.Errorf("catalog item %q にレイヤーが設定されていません", item.Name)
+		}
+		if item.Warmth <= 0 {
+			t.Errorf("catalog item %q の暖かさが設定されていません", item.Name)
+		}
+	}
+}
+
+func TestParseCatalog_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name: "ショップの固有名が足りない",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+  - { key: uniqlo, display_name: ユニクロ }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: `missing name for shop "uniqlo"`,
+		},
+		{
+			name: "固有名が空文字",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: "" }
+`,
+			wantErr: "is empty",
+		},
+		{
+			name: "未知のカテゴリー",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: shoes
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "unknown category",
+		},
+		{
+			name: "汎用名の重複",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "duplicate item",
+		},
+		{
+			name: "ブランドカラーの形式が不正",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋, brand_color: blue }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "must be #RRGGBB",
+		},
+		{
+			name: "商品の URL が不正",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+    products:
+      - { shop: nishimatsuya, url: /items/1, price: { min: 300, max: 300 }, sizes: [50] }
+`,
+			wantErr: "absolute http(s) URL",
+		},
+		{
+			name: "JAN コードのチェックデジットが不正",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+    products:
+      - { shop: nishimatsuya, jan: "4006381333932", url: "https://example.com/1", price: { min: 300, max: 300 }, sizes: [50] }
+`,
+			wantErr: "invalid jan",
+		},
+		{
+			name: "商品の価格帯が逆",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+    products:
+      - { shop: nishimatsuya, url: "https://example.com/1", price: { min: 500, max: 300 }, sizes: [50] }
+`,
+			wantErr: "price",
+		},
+		{
+			name: "未対応のバージョン",
+			input: `
+version: 3
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "unsupported catalog version",
+		},
+		{
+			name: "未知のレイヤー",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    layer: skin
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "unknown layer",
+		},
+		{
+			name: "カテゴリーとレイヤーの食い違い",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+  middle: { label: ミドル }
+items:
+  - name: ボディースーツ
+    category: middle
+    layer: inner
+    shop_names: { nishimatsuya: ボディースーツ }
+`,
+			wantErr: "does not match layer",
+		},
+		{
+			name: "暖かさが負の値",
+			input: `
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    warmth: -0.1
+    shop_names: { nishimatsuya: 短肌着 }
+`,
+			wantErr: "warmth must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.ParseCatalog([]byte(tt.input))
+			if err == nil {
+				t.Fatal("ParseCatalog should return an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCatalog_Item(t *testing.T) {
+	catalog := domain.DefaultCatalog()
+
+	item, ok := catalog.Item("カバーオール")
+	if !ok {
+		t.Fatal("Item(カバーオール) not found")
+	}
+	if got := item.ShopNames["akachan_honpo"]; got != "ドレスオール" {
+		t.Errorf("akachan_honpo name = %q, want ドレスオール", got)
+	}
+	// カテゴリーはレイヤー（middle）から決まる
+	if cat, _ := catalog.CategoryOf("カバーオール"); cat.Label != "ミドル" {
+		t.Errorf("CategoryOf(カバーオール).Label = %q, want ミドル", cat.Label)
+	}
+
+	if _, ok := catalog.Item("宇宙服"); ok {
+		t.Error("Item(宇宙服) should not be found")
+	}
+}
+
+// TestParseCatalog_V1 はバージョン 1 のカタログ（shops がショップ ID の一覧）を移行して読み込めることを確認します。
+func TestParseCatalog_V1(t *testing.T) {
+	c, err := domain.ParseCatalog([]byte(`
+version: 1
+shops: [nishimatsuya, akachan_honpo]
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着, akachan_honpo: 短肌着 }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+
+	if c.Version != domain.CatalogVersion {
+		t.Errorf("Version = %d, want %d", c.Version, domain.CatalogVersion)
+	}
+	want := []domain.Shop{
+		{Key: "nishimatsuya", DisplayName: "nishimatsuya", Priority: 0},
+		{Key: "akachan_honpo", DisplayName: "akachan_honpo", Priority: 1},
+	}
+	if !slices.Equal(c.Shops, want) {
+		t.Errorf("Shops = %+v, want %+v", c.Shops, want)
+	}
+	if item, ok := c.Item("短肌着"); !ok || item.ShopNames["akachan_honpo"] != "短肌着" {
+		t.Errorf("Item(短肌着) = %+v, %v", item, ok)
+	}
+}
+
+// TestParseCatalog_ShopOrder はショップが priority の昇順（同じ場合はキーの順）に並ぶことを確認します。
+func TestParseCatalog_ShopOrder(t *testing.T) {
+	c, err := domain.ParseCatalog([]byte(`
+version: 2
+shops:
+  - { key: c, display_name: C, priority: 2 }
+  - { key: b, display_name: B, priority: 1 }
+  - { key: a, display_name: A, priority: 2 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { a: 短肌着, b: 短肌着, c: 短肌着 }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+
+	var keys []string
+	for _, shop := range c.Shops {
+		keys = append(keys, shop.Key)
+	}
+	if want := []string{"b", "a", "c"}; !slices.Equal(keys, want) {
+		t.Errorf("shop order = %v, want %v", keys, want)
+	}
+
+	var defaults []string
+	for _, shop := range domain.DefaultCatalog().Shops {
+		defaults = append(defaults, shop.Key)
+	}
+	if !slices.Equal(defaults, expectedShops) {
+		t.Errorf("default shop order = %v, want %v", defaults, expectedShops)
+	}
+}
+
+func TestCatalog_Offers(t *testing.T) {
+	c, err := domain.ParseCatalog([]byte(`
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+  - { key: uniqlo, display_name: ユニクロ }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着, uniqlo: コットン前開き短肌着 }
+    products:
+      - { shop: nishimatsuya, sku: small, jan: "4006381333931", url: "https://example.com/small", price: { min: 300, max: 400 }, sizes: [50, 60] }
+      - { shop: nishimatsuya, sku: large, url: "https://example.com/large", price: { min: 500, max: 500 }, sizes: [70, 80] }
+      - { shop: uniqlo, sku: uq, url: "https://example.com/uq", price: { min: 990, max: 990 }, sizes: [60, 70] }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+
+	tests := []struct {
+		shop string
+		size domain.Size
+		want []string
+	}{
+		{shop: "nishimatsuya", size: domain.Size50To60, want: []string{"small"}},
+		{shop: "nishimatsuya", size: domain.Size60To70, want: []string{"small", "large"}},
+		{shop: "nishimatsuya", size: domain.Size90, want: nil},
+		{shop: "uniqlo", size: domain.Size80, want: nil},
+		{shop: "uniqlo", size: domain.Size70To80, want: []string{"uq"}},
+	}
+	for _, tt := range tests {
+		var skus []string
+		for _, p := range c.Offers("短肌着", tt.shop, tt.size) {
+			skus = append(skus, p.SKU)
+		}
+		if !slices.Equal(skus, tt.want) {
+			t.Errorf("Offers(短肌着, %s, %s) = %v, want %v", tt.shop, tt.size, skus, tt.want)
+		}
+	}
+}
+
+func TestCatalog_Available(t *testing.T) {
+	catalog := domain.DefaultCatalog()
+
+	tests := []struct {
+		item string
+		shop string
+		size domain.Size
+		want bool
+	}{
+		{item: "短肌着", shop: "nishimatsuya", size: domain.Size50To60, want: true},
+		{item: "短肌着", shop: "nishimatsuya", size: domain.Size70To80, want: false},
+		{item: "カバーオール", shop: "uniqlo", size: domain.Size80, want: true},
+		{item: "カバーオール", shop: "akachan_honpo", size: domain.Size80, want: false},
+		{item: "ボディースーツ", shop: "uniqlo", size: domain.Size90Plus, want: true},
+		{item: "ボディースーツ", shop: "uniqlo", size: domain.Size110, want: false},
+		{item: "宇宙服", shop: "uniqlo", size: domain.Size80, want: false},
+	}
+	for _, tt := range tests {
+		if got := catalog.Available(tt.item, tt.shop, tt.size); got != tt.want {
+			t.Errorf("Available(%s, %s, %s) = %v, want %v", tt.item, tt.shop, tt.size, got, tt.want)
+		}
+	}
+
+	// 取り扱いサイズが登録されていないショップは、すべてのサイズを扱っているとみなす
+	c, err := domain.ParseCatalog([]byte(`
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+	if !c.Available("短肌着", "nishimatsuya", domain.Size90) {
+		t.Error("Available should be true when sizes are not registered")
+	}
+}
+
+// TestDefaultCatalog_Localized は、すべてのアイテムとカテゴリーに対応言語の翻訳があることを確認します。
+func TestDefaultCatalog_Localized(t *testing.T) {
+	catalog := domain.DefaultCatalog()
+	for _, lang := range domain.SupportedLanguages {
+		for _, item := range catalog.Items {
+			if lang != domain.LangJA && item.Names[lang] == "" {
+				t.Errorf("item %q has no %s name", item.Name, lang)
+			}
+			if item.Descriptions[lang] == "" {
+				t.Errorf("item %q has no %s description", item.Name, lang)
+			}
+		}
+		for key, cat := range catalog.Categories {
+			if lang != domain.LangJA && cat.Labels[lang] == "" {
+				t.Errorf("category %q has no %s label", key, lang)
+			}
+		}
+	}
+
+	item, _ := catalog.Item("短肌着")
+	if got := item.DisplayName(domain.LangEN); got != "Short undershirt (短肌着)" {
+		t.Errorf("DisplayName(en) = %q, want %q", got, "Short undershirt (短肌着)")
+	}
+	if got := item.DisplayName(domain.LangJA); got != "短肌着" {
+		t.Errorf("DisplayName(ja) = %q, want 短肌着", got)
+	}
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Context は服を着るシーン（室内・お出かけ・ねんね）です。
+type Context string
+
+const (
+	ContextIndoor Context = "indoor" // 室内で過ごす
+	ContextOuting Context = "outing" // 外出する
+	ContextSleep  Context = "sleep"  // 寝る
+)
+
+// Contexts はすべてのシーンです（レスポンスではこの順に並べます）。
+var Contexts = []Context{ContextIndoor, ContextOuting, ContextSleep}
+
+// 室内・寝室の気温の目安（℃）です。冷暖房で外気温よりも狭い範囲に保たれるものとします。
+const (
+	indoorMinTemp = 20.0
+	indoorMaxTemp = 24.0
+	// 夜間は暖房を弱めることが多いため、寝室の下限は日中の室内より低めにする
+	bedroomMinTemp = 18.0
+	bedroomMaxTemp = 24.0
+)
+
+// contextLabels はシーンの表示名です。
+var contextLabels = map[Context]LocalizedText{
+	ContextIndoor: {LangJA: "室内", LangEN: "Indoors", LangZH: "室内", LangKO: "실내", LangVI: "Trong nhà"},
+	ContextOuting: {LangJA: "お出かけ", LangEN: "Outing", LangZH: "外出", LangKO: "외출", LangVI: "Ra ngoài"},
+	ContextSleep:  {LangJA: "ねんね", LangEN: "Sleep", LangZH: "睡觉", LangKO: "잠잘 때", LangVI: "Khi ngủ"},
+}
+
+// ParseContext は文字列をシーンに変換します。
+func ParseContext(s string) (Context, error) {
+	c := Context(s)
+	if !slices.Contains(Contexts, c) {
+		return "", fmt.Errorf("unknown context: %q", s)
+	}
+	return c, nil
+}
+
+// Label は lang でのシーンの表示名を返します。
+func (c Context) Label(lang Language) string {
+	return contextLabels[c].In(lang)
+}
+
+// EffectiveTemperature は外気温の推定から、このシーンで赤ちゃんが過ごす場所の気温を求めます。
+//   - indoor: 冷暖房で 20〜24℃ に保たれる室温（外気温をその範囲に収める）
+//   - outing: 外気温そのもの
+//   - sleep: 夜（最低気温）の寝室の室温。18〜24℃ に収め、寝ている間は変わらないものとする
+func (c Context) EffectiveTemperature(outdoor TemperatureEstimate) TemperatureEstimate {
+	switch c {
+	case ContextIndoor:
+		return TemperatureEstimate{
+			Mean: clamp(outdoor.Mean, indoorMinTemp, indoorMaxTemp),
+			Min:  clamp(outdoor.Min, indoorMinTemp, indoorMaxTemp),
+			Max:  clamp(outdoor.Max, indoorMinTemp, indoorMaxTemp),
+		}
+	case ContextSleep:
+		t := clamp(outdoor.Min, bedroomMinTemp, bedroomMaxTemp)
+		return TemperatureEstimate{Mean: t, Min: t, Max: t}
+	}
+	return outdoor
+}
+
+// clamp は v を lo 以上 hi 以下に収めます。
+func clamp(v, lo, hi float64) float64 {
+	return min(max(v, lo), hi)
+}
@@ -0,0 +1,70 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestParseContext(t *testing.T) {
+	for _, ctx := range domain.Contexts {
+		got, err := domain.ParseContext(string(ctx))
+		if err != nil || got != ctx {
+			t.Errorf("ParseContext(%q) = %q, %v; want %q", ctx, got, err, ctx)
+		}
+		if ctx.Label(domain.LangJA) == "" || ctx.Label(domain.LangEN) == "" {
+			t.Errorf("context %q has no label", ctx)
+		}
+	}
+	if _, err := domain.ParseContext("bath"); err == nil {
+		t.Error("ParseContext(bath) should fail")
+	}
+}
+
+func TestContext_EffectiveTemperature(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     domain.Context
+		outdoor domain.TemperatureEstimate
+		want    domain.TemperatureEstimate
+	}{
+		{
+			name:    "室内は冷暖房で20〜24℃に収まる（冬）",
+			ctx:     domain.ContextIndoor,
+			outdoor: domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9},
+			want:    domain.TemperatureEstimate{Mean: 20, Min: 20, Max: 20},
+		},
+		{
+			name:    "室内は冷暖房で20〜24℃に収まる（夏）",
+			ctx:     domain.ContextIndoor,
+			outdoor: domain.TemperatureEstimate{Mean: 27, Min: 23, Max: 31},
+			want:    domain.TemperatureEstimate{Mean: 24, Min: 23, Max: 24},
+		},
+		{
+			name:    "お出かけは外気温そのもの",
+			ctx:     domain.ContextOuting,
+			outdoor: domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9},
+			want:    domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9},
+		},
+		{
+			name:    "ねんねは夜の寝室の室温（下限18℃）",
+			ctx:     domain.ContextSleep,
+			outdoor: domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9},
+			want:    domain.TemperatureEstimate{Mean: 18, Min: 18, Max: 18},
+		},
+		{
+			name:    "ねんねは最低気温で決まる（春）",
+			ctx:     domain.ContextSleep,
+			outdoor: domain.TemperatureEstimate{Mean: 23, Min: 19, Max: 27},
+			want:    domain.TemperatureEstimate{Mean: 19, Min: 19, Max: 19},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ctx.EffectiveTemperature(tt.outdoor); got != tt.want {
+				t.Errorf("EffectiveTemperature(%+v) = %+v, want %+v", tt.outdoor, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"bytes"
+	"cmp"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//go:embed growth/mhlw2010.csv
+var growthCSV []byte
+
+// Sex は発育曲線を選ぶための性別です。空文字は男女の平均を使うことを表します。
+type Sex string
+
+const (
+	SexMale   Sex = "male"
+	SexFemale Sex = "female"
+)
+
+// GrowthMetric は発育曲線の指標です。
+type GrowthMetric string
+
+const (
+	MetricHeight GrowthMetric = "height_cm"
+	MetricWeight GrowthMetric = "weight_kg"
+)
+
+// z97 は97パーセンタイルに対応する標準正規分布の z スコアです。
+const z97 = 1.881
+
+// maxZScore は外れ値による極端な推定を避けるための z スコアの上限です。
+const maxZScore = 3.0
+
+// daysPerMonth は日数を小数の月齢に換算するための1ヶ月の平均日数です。
+const daysPerMonth = 365.25 / 12
+
+// growthRow は発育曲線の1行（ある月齢のパーセンタイル値）です。
+type growthRow struct {
+	AgeInMonths  float64
+	P3, P50, P97 float64
+}
+
+// growthKey は発育曲線を引くためのキーです。
+type growthKey struct {
+	sex    Sex
+	metric GrowthMetric
+}
+
+// growthTables は埋め込みの発育曲線を一度だけ読み込みます。
+var growthTables = sync.OnceValue(func() map[growthKey][]growthRow {
+	tables, err := parseGrowthCSV(growthCSV)
+	if err != nil {
+		panic(fmt.Sprintf("domain: invalid growth table: %v", err))
+	}
+	return tables
+})
+
+// parseGrowthCSV は発育曲線の CSV（sex,metric,age_months,p3,p50,p97）を読み込みます。
+func parseGrowthCSV(data []byte) (map[growthKey][]growthRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comment = '#'
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	tables := map[growthKey][]growthRow{}
+	for i, rec := range records[1:] {
+		if len(rec) != 6 {
+			return nil, fmt.Errorf("row %d: want 6 columns, got %d", i+1, len(rec))
+		}
+		var nums [4]float64
+		for j, s := range rec[2:] {
+			if nums[j], err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, fmt.Errorf("row %d: %w", i+1, err)
+			}
+		}
+		key := growthKey{sex: Sex(rec[0]), metric: GrowthMetric(rec[1])}
+		tables[key] = append(tables[key], growthRow{AgeInMonths: nums[0], P3: nums[1], P50: nums[2], P97: nums[3]})
+	}
+	for _, rows := range tables {
+		slices.SortFunc(rows, func(a, b growthRow) int { return cmp.Compare(a.AgeInMonths, b.AgeInMonths) })
+	}
+	return tables, nil
+}
+
+// growthPercentiles は月齢に対応するパーセンタイル値を、表の前後の行から線形補間して返します。
+// sex が空の場合は男女の平均を返します。
+func growthPercentiles(sex Sex, metric GrowthMetric, ageInMonths float64) growthRow {
+	if sex != SexMale && sex != SexFemale {
+		m := growthPercentiles(SexMale, metric, ageInMonths)
+		f := growthPercentiles(SexFemale, metric, ageInMonths)
+		return growthRow{
+			AgeInMonths: ageInMonths,
+			P3:          (m.P3 + f.P3) / 2,
+			P50:         (m.P50 + f.P50) / 2,
+			P97:         (m.P97 + f.P97) / 2,
+		}
+	}
+
+	rows := growthTables()[growthKey{sex: sex, metric: metric}]
+	if ageInMonths <= rows[0].AgeInMonths {
+		return rows[0]
+	}
+	for i := 1; i < len(rows); i++ {
+		if ageInMonths <= rows[i].AgeInMonths {
+			a, b := rows[i-1], rows[i]
+			w := (ageInMonths - a.AgeInMonths) / (b.AgeInMonths - a.AgeInMonths)
+			return growthRow{
+				AgeInMonths: ageInMonths,
+				P3:          a.P3 + (b.P3-a.P3)*w,
+				P50:         a.P50 + (b.P50-a.P50)*w,
+				P97:         a.P97 + (b.P97-a.P97)*w,
+			}
+		}
+	}
+	return rows[len(rows)-1]
+}
+
+// zScore は値が発育曲線上でどの位置にあるかを z スコアで返します。
+// 分布の歪みを考慮して、中央値の上下で別々に 3/97 パーセンタイルとの幅を使います。
+func (p growthRow) zScore(value float64) float64 {
+	var z float64
+	if value >= p.P50 {
+		z = z97 * (value - p.P50) / (p.P97 - p.P50)
+	} else {
+		z = z97 * (value - p.P50) / (p.P50 - p.P3)
+	}
+	return math.Max(-maxZScore, math.Min(maxZScore, z))
+}
+
+// valueAt は z スコアに対応する値を返します（zScore の逆変換）。
+func (p growthRow) valueAt(z float64) float64 {
+	if z >= 0 {
+		return p.P50 + z*(p.P97-p.P50)/z97
+	}
+	return p.P50 + z*(p.P50-p.P3)/z97
+}
+
+// Measurement は健診などで測った身長・体重です。
+type Measurement struct {
+	AgeInMonths float64  // 測定時の（修正）月齢
+	HeightCM    *float64 // 身長（cm）
+	WeightKG    *float64 // 体重（kg）
+	Sex         Sex
+}
+
+// ErrInvalidMeasurement は身長・体重の値が現実的でない場合のエラーです。
+var ErrInvalidMeasurement = errors.New("invalid measurement")
+
+// Validate は測定値が現実的な範囲にあるかを確認します。
+func (m Measurement) Validate() error {
+	if m.AgeInMonths < 0 {
+		return fmt.Errorf("%w: measured before birth", ErrInvalidMeasurement)
+	}
+	if m.HeightCM != nil && (*m.HeightCM < 30 || *m.HeightCM > 130) {
+		return fmt.Errorf("%w: height_cm must be between 30 and 130", ErrInvalidMeasurement)
+	}
+	if m.WeightKG != nil && (*m.WeightKG < 1 || *m.WeightKG > 40) {
+		return fmt.Errorf("%w: weight_kg must be between 1 and 40", ErrInvalidMeasurement)
+	}
+	if m.Sex != "" && m.Sex != SexMale && m.Sex != SexFemale {
+		return fmt.Errorf("%w: unknown sex %q", ErrInvalidMeasurement, m.Sex)
+	}
+	return nil
+}
+
+// HeightZScore は測定時の身長の z スコアを返します。
+// 身長がない場合は、体重の z スコアで代用します（身長と体重のパーセンタイルは相関が高いため）。
+// どちらもない場合は false を返します。
+func (m Measurement) HeightZScore() (float64, bool) {
+	switch {
+	case m.HeightCM != nil:
+		return growthPercentiles(m.Sex, MetricHeight, m.AgeInMonths).zScore(*m.HeightCM), true
+	case m.WeightKG != nil:
+		return growthPercentiles(m.Sex, MetricWeight, m.AgeInMonths).zScore(*m.WeightKG), true
+	}
+	return 0, false
+}
+
+// ProjectHeight は測定時のパーセンタイルを保ったまま成長したと仮定して、指定した月齢の身長を推定します。
+func (m Measurement) ProjectHeight(ageInMonths float64) (float64, bool) {
+	z, ok := m.HeightZScore()
+	if !ok {
+		return 0, false
+	}
+	return growthPercentiles(m.Sex, MetricHeight, ageInMonths).valueAt(z), true
+}
+
+// FractionalAgeInMonths は from から to までの期間を小数の月齢で返します。
+func FractionalAgeInMonths(from, to time.Time) float64 {
+	return to.Sub(from).Hours() / 24 / daysPerMonth
+}
@@ -0,0 +1,101 @@
+package domain_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestMeasurement_ProjectHeight(t *testing.T) {
+	tests := []struct {
+		name        string
+		measurement domain.Measurement
+		projectAt   float64
+		wantNear    float64
+	}{
+		{
+			name:        "中央値の男の子は12ヶ月でも中央値",
+			measurement: domain.Measurement{AgeInMonths: 3, HeightCM: ptr(61.9), Sex: domain.SexMale},
+			projectAt:   12,
+			wantNear:    74.9,
+		},
+		{
+			name:        "97パーセンタイルの女の子は12ヶ月でも97パーセンタイル",
+			measurement: domain.Measurement{AgeInMonths: 6, HeightCM: ptr(70.4), Sex: domain.SexFemale},
+			projectAt:   12,
+			wantNear:    77.8,
+		},
+		{
+			name:        "性別なしは男女平均の曲線を使う",
+			measurement: domain.Measurement{AgeInMonths: 0, HeightCM: ptr(48.75)},
+			projectAt:   12,
+			wantNear:    (74.9 + 73.4) / 2,
+		},
+		{
+			name:        "身長がなければ体重のパーセンタイルで代用",
+			measurement: domain.Measurement{AgeInMonths: 1, WeightKG: ptr(4.78), Sex: domain.SexMale},
+			projectAt:   18,
+			wantNear:    80.5,
+		},
+		{
+			name:        "表の行の間の月齢は補間する",
+			measurement: domain.Measurement{AgeInMonths: 13.5, HeightCM: ptr(76.3), Sex: domain.SexMale},
+			projectAt:   13.5,
+			wantNear:    76.3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.measurement.ProjectHeight(tt.projectAt)
+			if !ok {
+				t.Fatal("ProjectHeight returned ok=false")
+			}
+			if math.Abs(got-tt.wantNear) > 0.2 {
+				t.Errorf("ProjectHeight(%v) = %.2f, want about %.2f", tt.projectAt, got, tt.wantNear)
+			}
+		})
+	}
+}
+
+func TestMeasurement_ProjectHeight_NoValues(t *testing.T) {
+	if _, ok := (domain.Measurement{AgeInMonths: 3}).ProjectHeight(12); ok {
+		t.Error("ProjectHeight should return ok=false without height or weight")
+	}
+}
+
+func TestMeasurement_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		measurement domain.Measurement
+		wantErr     bool
+	}{
+		{name: "正常", measurement: domain.Measurement{AgeInMonths: 3, HeightCM: ptr(61), WeightKG: ptr(6.5), Sex: domain.SexMale}},
+		{name: "身長が小さすぎる", measurement: domain.Measurement{HeightCM: ptr(10)}, wantErr: true},
+		{name: "体重が大きすぎる", measurement: domain.Measurement{WeightKG: ptr(80)}, wantErr: true},
+		{name: "未知の性別", measurement: domain.Measurement{HeightCM: ptr(61), Sex: "other"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.measurement.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidMeasurement) {
+				t.Errorf("error should wrap ErrInvalidMeasurement: %v", err)
+			}
+		})
+	}
+}
+
+func TestFractionalAgeInMonths(t *testing.T) {
+	birth := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	got := domain.FractionalAgeInMonths(birth, birth.AddDate(1, 0, 0))
+	if math.Abs(got-12) > 0.05 {
+		t.Errorf("FractionalAgeInMonths(1 year) = %v, want about 12", got)
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"golang.org/x/text/language"
+)
+
+// Language は表示に使う言語（BCP 47 の基本言語コード）です。
+type Language string
+
+const (
+	LangJA Language = "ja"
+	LangEN Language = "en"
+	LangZH Language = "zh"
+	LangKO Language = "ko"
+	LangVI Language = "vi"
+)
+
+// DefaultLanguage は言語の指定がない場合に使う言語です。
+const DefaultLanguage = LangJA
+
+// SupportedLanguages は対応している言語の一覧です。
+var SupportedLanguages = []Language{LangJA, LangEN, LangZH, LangKO, LangVI}
+
+// ErrUnsupportedLanguage は言語タグが正しくないか、対応していない言語の場合のエラーです。
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// ParseLanguage は言語タグ（"en"、"en-US"、"zh-Hans" など）を対応している言語に変換します。
+func ParseLanguage(s string) (Language, error) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid language tag %q", ErrUnsupportedLanguage, s)
+	}
+	base, _ := tag.Base()
+	lang := Language(base.String())
+	if !slices.Contains(SupportedLanguages, lang) {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedLanguage, s)
+	}
+	return lang, nil
+}
+
+// NegotiateLanguage は Accept-Language ヘッダーの値から、対応している言語のうち最も優先度の高いものを選びます。
+// 対応している言語が含まれていない場合は DefaultLanguage を返します。
+func NegotiateLanguage(acceptLanguage string) Language {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return DefaultLanguage
+	}
+	for _, tag := range tags {
+		if lang, err := ParseLanguage(tag.String()); err == nil {
+			return lang
+		}
+	}
+	return DefaultLanguage
+}
+
+// LocalizedText は言語ごとの文字列です。
+type LocalizedText map[Language]string
+
+// In は lang の文字列を返します。lang の文字列がなければ日本語の文字列を返します。
+func (t LocalizedText) In(lang Language) string {
+	if s, ok := t[lang]; ok && s != "" {
+		return s
+	}
+	return t[LangJA]
+}
@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    domain.Language
+		wantErr bool
+	}{
+		{input: "en", want: domain.LangEN},
+		{input: "en-US", want: domain.LangEN},
+		{input: "zh-Hans", want: domain.LangZH},
+		{input: "ko-KR", want: domain.LangKO},
+		{input: "vi", want: domain.LangVI},
+		{input: "ja-JP", want: domain.LangJA},
+		{input: "fr", wantErr: true},
+		{input: "!!", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := domain.ParseLanguage(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLanguage(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err != nil && !errors.Is(err, domain.ErrUnsupportedLanguage) {
+			t.Errorf("ParseLanguage(%q) error = %v, want ErrUnsupportedLanguage", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLanguage(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   domain.Language
+	}{
+		{header: "", want: domain.LangJA},
+		{header: "en-US,en;q=0.9", want: domain.LangEN},
+		{header: "fr-FR,fr;q=0.9,ko;q=0.8,en;q=0.5", want: domain.LangKO},
+		{header: "en;q=0.5,vi;q=0.8", want: domain.LangVI},
+		{header: "fr", want: domain.LangJA},
+	}
+	for _, tt := range tests {
+		if got := domain.NegotiateLanguage(tt.header); got != tt.want {
+			t.Errorf("NegotiateLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
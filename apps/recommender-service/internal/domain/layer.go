@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Layer は重ね着のレイヤーです。
+type Layer string
+
+const (
+	LayerInner     Layer = "inner"     // 肌着
+	LayerMiddle    Layer = "middle"    // 肌着の上に着る服
+	LayerOuter     Layer = "outer"     // 服の上に重ねる上着・スリーパー
+	LayerAccessory Layer = "accessory" // 帽子・靴下などの小物
+)
+
+// Layers はすべてのレイヤーです（内側から外側の順）。
+var Layers = []Layer{LayerInner, LayerMiddle, LayerOuter, LayerAccessory}
+
+// ParseLayer は文字列をレイヤーに変換します。
+func ParseLayer(s string) (Layer, error) {
+	l := Layer(s)
+	if !slices.Contains(Layers, l) {
+		return "", fmt.Errorf("unknown layer: %q", s)
+	}
+	return l, nil
+}
+
+// order はレイヤーの内側からの順番です。レイヤーが未設定の場合は最後になります。
+func (l Layer) order() int {
+	if i := slices.Index(Layers, l); i >= 0 {
+		return i
+	}
+	return len(Layers)
+}
+
+// 目標の暖かさ（clo 値）を求めるモデルの定数です。
+// clo は服の断熱性の単位で、1 clo は大人が長袖シャツとズボンにジャケットを着たくらいです。
+const (
+	// neutralTemperature は薄着で暑くも寒くもない気温の目安（℃）です。
+	neutralTemperature = 27.0
+	// warmthPerDegree は気温が1℃下がるごとに必要になる暖かさ（clo）です。
+	warmthPerDegree = 0.035
+	// minTargetWarmth は暑い日でも最低限必要な暖かさ（肌着1枚）です。
+	minTargetWarmth = 0.1
+	// activeAgeMonths 以降は自分で動き回って体が温まるので、目標を activeWarmthOffset だけ下げます。
+	activeAgeMonths    = 12
+	activeWarmthOffset = 0.05
+	// equivalentWarmthTolerance は代わりに着られるアイテムとみなす暖かさの差（clo）です。
+	equivalentWarmthTolerance = 0.1
+)
+
+// roundWarmth は暖かさを小数第2位で丸めます。
+// 浮動小数点の誤差で、合計や差がちょうど境界の値のときに比較の結果が変わらないようにします。
+func roundWarmth(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// TargetWarmth は気温と月齢から、コーディネートに必要な暖かさ（clo）の目安を求めます。
+// 薄着で快適な気温から1℃下がるごとに warmthPerDegree ずつ増やし、よく動く1歳以降は少し減らします。
+func TargetWarmth(ageInMonths int, temperature float64) float64 {
+	target := (neutralTemperature - temperature) * warmthPerDegree
+	if ageInMonths >= activeAgeMonths {
+		target -= activeWarmthOffset
+	}
+	return max(target, minTargetWarmth)
+}
@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestParseLayer(t *testing.T) {
+	for _, l := range domain.Layers {
+		if got, err := domain.ParseLayer(string(l)); err != nil || got != l {
+			t.Errorf("ParseLayer(%q) = %q, %v; want %q", l, got, err, l)
+		}
+	}
+	if _, err := domain.ParseLayer("shoes"); err == nil {
+		t.Error("ParseLayer(shoes) should fail")
+	}
+}
+
+func TestTargetWarmth(t *testing.T) {
+	tests := []struct {
+		name        string
+		ageInMonths int
+		temperature float64
+		want        float64
+	}{
+		{name: "快適な気温では肌着1枚分", ageInMonths: 0, temperature: 27, want: 0.1},
+		{name: "猛暑でも肌着1枚分は必要", ageInMonths: 0, temperature: 35, want: 0.1},
+		{name: "20℃", ageInMonths: 2, temperature: 20, want: 0.245},
+		{name: "0℃", ageInMonths: 6, temperature: 0, want: 0.945},
+		{name: "1歳以降はよく動くので少なめ", ageInMonths: 12, temperature: 0, want: 0.895},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := domain.TargetWarmth(tt.ageInMonths, tt.temperature); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TargetWarmth(%d, %v) = %v, want %v", tt.ageInMonths, tt.temperature, got, tt.want)
+			}
+		})
+	}
+}
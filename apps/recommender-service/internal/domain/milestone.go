@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Granularity はマイルストーンの間隔の細かさです。
+type Granularity string
+
+const (
+	GranularityMonthly Granularity = "monthly" // 月ごと
+	GranularityWeekly  Granularity = "weekly"  // 生後 weeklyUntilMonth ヶ月までは週ごと、それ以降は月ごと
+)
+
+const (
+	// MaxMilestoneMonth はマイルストーンを算出できる最大の月齢（6歳、サイズ120まで）です。
+	MaxMilestoneMonth = 72
+	// weeklyUntilMonth は週ごとのマイルストーンを算出する期間（生後の月数）です。
+	// サイズが最も早く変わる時期なので、月ごとよりも細かく示します。
+	weeklyUntilMonth = 3
+)
+
+// DefaultMilestoneSchedule は指定がない場合のマイルストーンの範囲（0〜24ヶ月を1ヶ月ごと）です。
+var DefaultMilestoneSchedule = MilestoneSchedule{FromMonth: 0, ToMonth: 24, Step: 1, Granularity: GranularityMonthly}
+
+// ErrInvalidMilestoneRange はマイルストーンの範囲の指定が正しくない場合のエラーです。
+var ErrInvalidMilestoneRange = errors.New("invalid milestone range")
+
+// ErrDateOutOfRange はコーディネートを求める日付が生年月日から MaxMilestoneMonth ヶ月の範囲にない場合のエラーです。
+var ErrDateOutOfRange = errors.New("date is out of range for the birth date")
+
+// ValidateTargetDate は date が生年月日から MaxMilestoneMonth ヶ月後までの範囲にあるかを確認します。
+func ValidateTargetDate(birthDate, date time.Time) error {
+	latest := birthDate.AddDate(0, MaxMilestoneMonth, 0)
+	if date.Before(birthDate) || date.After(latest) {
+		return fmt.Errorf("%w: date must be between %s and %s",
+			ErrDateOutOfRange, birthDate.Format(time.DateOnly), latest.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// MilestoneSchedule はマイルストーンを算出する範囲と間隔です。
+type MilestoneSchedule struct {
+	FromMonth   int // 最初のマイルストーンの月齢
+	ToMonth     int // 最後のマイルストーンの月齢（この月齢を含む）
+	Step        int // 月ごとのマイルストーンの間隔（月数）
+	Granularity Granularity
+}
+
+// MilestonePoint はマイルストーンを算出する時点です。
+type MilestonePoint struct {
+	Date time.Time
+	// Weeks は週ごとのマイルストーンの生後の週数です（月ごとのマイルストーンでは nil）
+	Weeks *int
+}
+
+// ParseGranularity は文字列をマイルストーンの間隔の細かさに変換します。
+func ParseGranularity(s string) (Granularity, error) {
+	g := Granularity(s)
+	if g != GranularityMonthly && g != GranularityWeekly {
+		return "", fmt.Errorf("unknown granularity: %q", s)
+	}
+	return g, nil
+}
+
+// Validate は範囲が 0〜MaxMilestoneMonth ヶ月に収まり、間隔が1ヶ月以上であるかを確認します。
+func (s MilestoneSchedule) Validate() error {
+	if s.FromMonth < 0 || s.ToMonth > MaxMilestoneMonth {
+		return fmt.Errorf("%w: from_month and to_month must be between 0 and %d", ErrInvalidMilestoneRange, MaxMilestoneMonth)
+	}
+	if s.FromMonth > s.ToMonth {
+		return fmt.Errorf("%w: from_month must not be greater than to_month", ErrInvalidMilestoneRange)
+	}
+	if s.Step < 1 {
+		return fmt.Errorf("%w: step must be at least 1", ErrInvalidMilestoneRange)
+	}
+	return nil
+}
+
+// Points は生年月日 birthDate からマイルストーンの時点を日付の順に返します。
+// 月ごとの時点は FromMonth から Step ヶ月ごとに ToMonth までです。
+// GranularityWeekly の場合、生後 weeklyUntilMonth ヶ月（ToMonth がそれより前ならその月齢）になるまでは
+// 月ごとの時点の代わりに、FromMonth の日付以降の1週間ごとの時点を返します。
+// 週ごとの時点は、そのあとの最初の月ごとの時点まで1週間以上あるものだけにします
+// （13週と3ヶ月のように、ほとんど同じ日付の時点が並ばないようにするため）。
+func (s MilestoneSchedule) Points(birthDate time.Time) []MilestonePoint {
+	weeklyUntil := s.FromMonth
+	if s.Granularity == GranularityWeekly {
+		weeklyUntil = max(s.FromMonth, min(s.ToMonth, weeklyUntilMonth))
+	}
+
+	var monthly []MilestonePoint
+	for m := s.FromMonth; m <= s.ToMonth; m += s.Step {
+		if m < weeklyUntil {
+			continue
+		}
+		monthly = append(monthly, MilestonePoint{Date: birthDate.AddDate(0, m, 0)})
+	}
+
+	var points []MilestonePoint
+	from, until := birthDate.AddDate(0, s.FromMonth, 0), birthDate.AddDate(0, weeklyUntil, 0)
+	for w := 0; ; w++ {
+		date := birthDate.AddDate(0, 0, 7*w)
+		if !date.Before(until) || len(monthly) > 0 && date.AddDate(0, 0, 7).After(monthly[0].Date) {
+			break
+		}
+		if date.Before(from) {
+			continue
+		}
+		points = append(points, MilestonePoint{Date: date, Weeks: &w})
+	}
+	return append(points, monthly...)
+}
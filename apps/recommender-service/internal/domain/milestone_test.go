@@ -0,0 +1,172 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestMilestoneSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule domain.MilestoneSchedule
+		wantErr  bool
+	}{
+		{name: "default", schedule: domain.DefaultMilestoneSchedule},
+		{name: "up to 6 years", schedule: domain.MilestoneSchedule{FromMonth: 24, ToMonth: 72, Step: 6}},
+		{name: "single month", schedule: domain.MilestoneSchedule{FromMonth: 5, ToMonth: 5, Step: 1}},
+		{name: "negative from", schedule: domain.MilestoneSchedule{FromMonth: -1, ToMonth: 24, Step: 1}, wantErr: true},
+		{name: "beyond 6 years", schedule: domain.MilestoneSchedule{FromMonth: 0, ToMonth: 73, Step: 1}, wantErr: true},
+		{name: "from after to", schedule: domain.MilestoneSchedule{FromMonth: 12, ToMonth: 6, Step: 1}, wantErr: true},
+		{name: "zero step", schedule: domain.MilestoneSchedule{FromMonth: 0, ToMonth: 24, Step: 0}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidMilestoneRange) {
+				t.Errorf("Validate() error = %v, want ErrInvalidMilestoneRange", err)
+			}
+		})
+	}
+}
+
+func TestMilestoneSchedule_Points(t *testing.T) {
+	birth := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		schedule  domain.MilestoneSchedule
+		wantDates []string
+		wantWeeks []int // 週ごとの時点の週数（月ごとの時点は -1）
+	}{
+		{
+			name:      "monthly with step",
+			schedule:  domain.MilestoneSchedule{FromMonth: 24, ToMonth: 36, Step: 6, Granularity: domain.GranularityMonthly},
+			wantDates: []string{"2027-10-01", "2028-04-01", "2028-10-01"},
+			wantWeeks: []int{-1, -1, -1},
+		},
+		{
+			name:      "step does not reach to_month",
+			schedule:  domain.MilestoneSchedule{FromMonth: 0, ToMonth: 5, Step: 2, Granularity: domain.GranularityMonthly},
+			wantDates: []string{"2025-10-01", "2025-12-01", "2026-02-01"},
+			wantWeeks: []int{-1, -1, -1},
+		},
+		{
+			name:     "weekly until 3 months then monthly",
+			schedule: domain.MilestoneSchedule{FromMonth: 0, ToMonth: 4, Step: 1, Granularity: domain.GranularityWeekly},
+			wantDates: []string{
+				"2025-10-01", "2025-10-08", "2025-10-15", "2025-10-22", "2025-10-29",
+				"2025-11-05", "2025-11-12", "2025-11-19", "2025-11-26",
+				"2025-12-03", "2025-12-10", "2025-12-17", "2025-12-24",
+				"2026-01-01", "2026-02-01",
+			},
+			wantWeeks: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, -1, -1},
+		},
+		{
+			name:      "weekly from the middle of the first 3 months",
+			schedule:  domain.MilestoneSchedule{FromMonth: 2, ToMonth: 3, Step: 1, Granularity: domain.GranularityWeekly},
+			wantDates: []string{"2025-12-03", "2025-12-10", "2025-12-17", "2025-12-24", "2026-01-01"},
+			wantWeeks: []int{9, 10, 11, 12, -1},
+		},
+		{
+			name:      "weekly ends at to_month",
+			schedule:  domain.MilestoneSchedule{FromMonth: 0, ToMonth: 1, Step: 1, Granularity: domain.GranularityWeekly},
+			wantDates: []string{"2025-10-01", "2025-10-08", "2025-10-15", "2025-10-22", "2025-11-01"},
+			wantWeeks: []int{0, 1, 2, 3, -1},
+		},
+		{
+			// 3ヶ月の時点がないので、13週（2025-12-31）まで週ごとに返す
+			name:      "weekly when step skips the 3rd month",
+			schedule:  domain.MilestoneSchedule{FromMonth: 2, ToMonth: 4, Step: 2, Granularity: domain.GranularityWeekly},
+			wantDates: []string{"2025-12-03", "2025-12-10", "2025-12-17", "2025-12-24", "2025-12-31", "2026-02-01"},
+			wantWeeks: []int{9, 10, 11, 12, 13, -1},
+		},
+		{
+			name:      "weekly has no effect after 3 months",
+			schedule:  domain.MilestoneSchedule{FromMonth: 6, ToMonth: 7, Step: 1, Granularity: domain.GranularityWeekly},
+			wantDates: []string{"2026-04-01", "2026-05-01"},
+			wantWeeks: []int{-1, -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := tt.schedule.Points(birth)
+			if len(points) != len(tt.wantDates) {
+				t.Fatalf("len(Points) = %d, want %d; points = %v", len(points), len(tt.wantDates), points)
+			}
+			for i, p := range points {
+				if got := p.Date.Format(time.DateOnly); got != tt.wantDates[i] {
+					t.Errorf("points[%d].Date = %s, want %s", i, got, tt.wantDates[i])
+				}
+				weeks := -1
+				if p.Weeks != nil {
+					weeks = *p.Weeks
+				}
+				if weeks != tt.wantWeeks[i] {
+					t.Errorf("points[%d].Weeks = %d, want %d", i, weeks, tt.wantWeeks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseGranularity(t *testing.T) {
+	for _, s := range []string{"monthly", "weekly"} {
+		if g, err := domain.ParseGranularity(s); err != nil || string(g) != s {
+			t.Errorf("ParseGranularity(%q) = %q, %v", s, g, err)
+		}
+	}
+	if _, err := domain.ParseGranularity("daily"); err == nil {
+		t.Error("ParseGranularity(\"daily\") should fail")
+	}
+}
+
+func TestValidateTargetDate(t *testing.T) {
+	birth := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		date    string
+		wantErr bool
+	}{
+		{date: "2025-10-01"},
+		{date: "2026-06-15"},
+		{date: "2031-10-01"},
+		{date: "2025-09-30", wantErr: true},
+		{date: "2031-10-02", wantErr: true},
+	}
+	for _, tt := range tests {
+		date, _ := time.Parse(time.DateOnly, tt.date)
+		err := domain.ValidateTargetDate(birth, date)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateTargetDate(%s) error = %v, wantErr %v", tt.date, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, domain.ErrDateOutOfRange) {
+			t.Errorf("ValidateTargetDate(%s) error = %v, want ErrDateOutOfRange", tt.date, err)
+		}
+	}
+}
+
+// TestMilestoneSchedule_Points_NoNearDuplicates は、週ごとの時点と月ごとの時点が1週間未満の間隔で並ばないことを確認します。
+func TestMilestoneSchedule_Points_NoNearDuplicates(t *testing.T) {
+	// 月末生まれや閏年をまたぐ場合も含めて確かめる
+	for _, birth := range []string{"2025-10-01", "2025-01-31", "2023-12-15", "2024-02-29"} {
+		birthDate, _ := time.Parse(time.DateOnly, birth)
+		for to := 1; to <= 4; to++ {
+			s := domain.MilestoneSchedule{FromMonth: 0, ToMonth: to, Step: 1, Granularity: domain.GranularityWeekly}
+			points := s.Points(birthDate)
+			for i := 1; i < len(points); i++ {
+				if gap := points[i].Date.Sub(points[i-1].Date); gap < 7*24*time.Hour {
+					t.Errorf("birth %s, to_month %d: %s and %s are only %v apart", birth, to,
+						points[i-1].Date.Format(time.DateOnly), points[i].Date.Format(time.DateOnly), gap)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// smallKana は小書きのカナを通常の大きさのカナに対応させる表です。
+var smallKana = map[rune]rune{
+	'ァ': 'ア', 'ィ': 'イ', 'ゥ': 'ウ', 'ェ': 'エ', 'ォ': 'オ',
+	'ッ': 'ツ', 'ャ': 'ヤ', 'ュ': 'ユ', 'ョ': 'ヨ', 'ヮ': 'ワ',
+	'ヵ': 'カ', 'ヶ': 'ケ',
+}
+
+// NormalizeName はアイテム名の表記ゆれを吸収するための正規化を行います。
+//   - 全角英数字を半角に、半角カナを全角に揃える（NFKC）
+//   - 英字を小文字に揃える
+//   - ひらがなをカタカナに揃える
+//   - 小書きのカナ（ァ、ッ、ャ など）を通常の大きさに揃える
+//   - 空白・中黒（・）・長音記号（ー）を取り除く（ボディスーツ／ボディースーツ を同一視する）
+func NormalizeName(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(s) {
+		switch {
+		case unicode.IsSpace(r), r == '・', r == 'ー':
+			continue
+		case 'ぁ' <= r && r <= 'ゖ':
+			// ひらがなとカタカナはコードポイントが 0x60 ずれている
+			r += 'ァ' - 'ぁ'
+		}
+		if large, ok := smallKana[r]; ok {
+			r = large
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
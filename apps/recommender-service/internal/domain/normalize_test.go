@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{a: "ボディスーツ", b: "ボディースーツ"},
+		{a: "ロンパス", b: "ロンパース"},
+		{a: "ぷれおーる", b: "プレオール"},
+		{a: " コンビ 肌着 ", b: "コンビ肌着"},
+		{a: "ツー・ウェイオール", b: "ツーウェイオール"},
+	}
+	for _, tt := range tests {
+		if got, want := domain.NormalizeName(tt.a), domain.NormalizeName(tt.b); got != want {
+			t.Errorf("NormalizeName(%q) = %q, NormalizeName(%q) = %q, want equal", tt.a, got, tt.b, want)
+		}
+	}
+
+	if domain.NormalizeName("短肌着") == domain.NormalizeName("コンビ肌着") {
+		t.Error("different names should not be normalized to the same string")
+	}
+}
+
+func TestNormalizeName_Width(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "ﾛﾝﾊﾟｰｽ", want: "ロンパス"},
+		{input: "ｺﾝﾋﾞ肌着", want: "コンビ肌着"},
+		{input: "ＵＮＩＱＬＯ", want: "uniqlo"},
+		{input: "ぼでぃすーつ", want: "ボデイスツ"},
+	}
+	for _, tt := range tests {
+		if got := domain.NormalizeName(tt.input); got != tt.want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
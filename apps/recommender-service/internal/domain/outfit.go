@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"math"
+	"slices"
+)
+
+// OutfitItem はレイヤーと暖かさを付けたコーディネートの1アイテムです。
+type OutfitItem struct {
+	Recommendation
+	Layer       Layer
+	Warmth      float64  // 暖かさ（clo）
+	Replaces    string   // 暖かさが足りないために置き換えた元のアイテム（置き換えていなければ空）
+	Equivalents []string // 同じレイヤーで暖かさが同じくらいの、代わりに着られるアイテム
+}
+
+// LayeredOutfit はアイテムをレイヤーの順に並べ、暖かさを評価したコーディネートです。
+type LayeredOutfit struct {
+	Context      Context
+	Temperature  TemperatureEstimate // そのシーンで過ごす場所の気温
+	Items        []OutfitItem        // レイヤーの順（同じレイヤーの中はルールの順）
+	Warmth       float64             // アイテムの暖かさの合計（clo）
+	TargetWarmth float64             // 気温と月齢から求めた目標の暖かさ（clo）
+	Alternatives []AlternativeOutfit // ルールの置き換え表（Substitutions）で作った代わりのコーディネート（よい順）
+}
+
+// MeetsTarget はコーディネートの暖かさが目標に届いているかどうかを返します。
+func (o LayeredOutfit) MeetsTarget() bool {
+	return roundWarmth(o.Warmth) >= roundWarmth(o.TargetWarmth)
+}
+
+// BuildOutfit はシーン ctx のコーディネートを組み立てます。
+// ルールで選んだアイテムにカタログのレイヤーと暖かさを付けて内側から順に並べ、
+// 暖かさの合計を日平均の気温と月齢から求めた目標（TargetWarmth）と比べます。
+// 目標に届かない場合は、外側のレイヤーのアイテムから順に、同じレイヤーでより暖かいアイテム
+// （そのシーン・月齢でルールが推薦することのあるもの）に置き換えます。
+// レイヤーのないアイテムは暖かさの合計にだけ含め、置き換えや代わりのアイテムの対象にしません。
+// 最後に、置き換え表を使った代わりのコーディネート（サイズ size で買えるアイテムのもの）を付けます。
+func (rs *RuleSet) BuildOutfit(c *Catalog, ctx Context, ageInMonths int, size Size, outdoor TemperatureEstimate) LayeredOutfit {
+	outfit := rs.Outfit(ctx, ageInMonths, outdoor)
+	o := LayeredOutfit{
+		Context:      ctx,
+		Temperature:  outfit.Temperature,
+		Items:        make([]OutfitItem, 0, len(outfit.Recommendations)),
+		TargetWarmth: TargetWarmth(ageInMonths, outfit.Temperature.Mean),
+	}
+	for _, rec := range outfit.Recommendations {
+		o.Items = append(o.Items, newOutfitItem(c, rec))
+	}
+	slices.SortStableFunc(o.Items, func(a, b OutfitItem) int {
+		return a.Layer.order() - b.Layer.order()
+	})
+	o.Warmth = totalWarmth(o.Items)
+
+	for !o.MeetsTarget() && rs.warmUp(c, &o, ageInMonths, size) {
+		o.Warmth = totalWarmth(o.Items)
+	}
+
+	for i, item := range o.Items {
+		o.Items[i].Equivalents = rs.equivalents(c, o, ageInMonths, item)
+	}
+	o.Alternatives = rs.alternatives(c, o, ageInMonths, size)
+	return o
+}
+
+// BuildOutfits はすべてのシーンのコーディネートを Contexts の順に組み立てます。
+func (rs *RuleSet) BuildOutfits(c *Catalog, ageInMonths int, size Size, outdoor TemperatureEstimate) []LayeredOutfit {
+	outfits := make([]LayeredOutfit, 0, len(Contexts))
+	for _, ctx := range Contexts {
+		outfits = append(outfits, rs.BuildOutfit(c, ctx, ageInMonths, size, outdoor))
+	}
+	return outfits
+}
+
+// newOutfitItem は推薦アイテムにカタログのレイヤーと暖かさを付けます。
+func newOutfitItem(c *Catalog, rec Recommendation) OutfitItem {
+	entry, _ := c.Item(rec.Item)
+	return OutfitItem{Recommendation: rec, Layer: entry.Layer, Warmth: entry.Warmth}
+}
+
+// warmUp は外側のレイヤーのアイテムから順に、同じレイヤーでより暖かいアイテムへの置き換えを1つ行います。
+// 置き換え先はその月齢で推薦され、サイズ size を扱うショップがあるアイテムに限ります。
+// 不足分を補える中で最も薄いもの、補えるものがなければ最も暖かいものを選びます。置き換えられなければ false を返します。
+func (rs *RuleSet) warmUp(c *Catalog, o *LayeredOutfit, ageInMonths int, size Size) bool {
+	shortfall := o.TargetWarmth - o.Warmth
+	for i := len(o.Items) - 1; i >= 0; i-- {
+		item := o.Items[i]
+		if item.Layer == "" {
+			continue
+		}
+
+		// a の方が b より置き換え先としてふさわしいかどうか
+		better := func(a, b *CatalogItem) bool {
+			aCloses, bCloses := a.Warmth-item.Warmth >= shortfall, b.Warmth-item.Warmth >= shortfall
+			if aCloses != bCloses {
+				return aCloses
+			}
+			if aCloses {
+				return a.Warmth < b.Warmth
+			}
+			return a.Warmth > b.Warmth
+		}
+
+		var best *CatalogItem
+		for _, cand := range c.Items {
+			if cand.Layer != item.Layer || cand.Warmth <= item.Warmth || o.contains(cand.Name) ||
+				!rs.wearable(c, []string{cand.Name}, o.Context, ageInMonths, size) {
+				continue
+			}
+			if best == nil || better(&cand, best) {
+				best = &cand
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		replaced := OutfitItem{
+			Recommendation: Recommendation{Item: best.Name, Reason: rs.replacementReason(best.Name, *o, ageInMonths)},
+			Layer:          best.Layer,
+			Warmth:         best.Warmth,
+			Replaces:       item.Item,
+		}
+		if item.Replaces != "" {
+			replaced.Replaces = item.Replaces
+		}
+		o.Items[i] = replaced
+		return true
+	}
+	return false
+}
+
+// replacementReason は warmUp で暖かさのために選んだ item の理由を作ります。
+// item を推薦するルールのうち、気温の範囲がそのシーンの日平均の気温に最も近いものを理由のルールとし、
+// 届かなかった目標の暖かさを TargetWarmth に記録します。
+func (rs *RuleSet) replacementReason(item string, o LayeredOutfit, ageInMonths int) Reason {
+	temperature := o.Temperature.Mean
+	reason := Reason{
+		AgeInMonths:      ageInMonths,
+		Temperature:      temperature,
+		TemperatureBasis: TemperatureBasisMean,
+		Context:          o.Context,
+		TargetWarmth:     o.TargetWarmth,
+	}
+	closest := math.Inf(1)
+	for _, g := range rs.Groups {
+		if !g.AppliesTo(o.Context) {
+			continue
+		}
+		for _, r := range g.Rules {
+			if !r.Age.Contains(float64(ageInMonths)) || !slices.Contains(r.Items, item) {
+				continue
+			}
+			if d := r.Temperature.distance(temperature); d < closest {
+				closest = d
+				reason.RuleID, reason.Group = r.ID, g.ID
+				reason.AgeBand, reason.TemperatureBand = r.Age, r.Temperature
+			}
+		}
+	}
+	return reason
+}
+
+// distance は v からこの範囲までの距離を返します（範囲に含まれる場合は 0）。
+func (b Band) distance(v float64) float64 {
+	switch {
+	case b.Min != nil && v < *b.Min:
+		return *b.Min - v
+	case b.Max != nil && v >= *b.Max:
+		return v - *b.Max
+	}
+	return 0
+}
+
+// equivalents は item の代わりに着られるアイテム（同じレイヤーで暖かさの差が equivalentWarmthTolerance 以内、
+// そのシーン・月齢でルールが推薦することのあるもの）をカタログの順に返します。
+func (rs *RuleSet) equivalents(c *Catalog, o LayeredOutfit, ageInMonths int, item OutfitItem) []string {
+	names := []string{}
+	if item.Layer == "" {
+		return names
+	}
+	for _, cand := range c.Items {
+		diff := roundWarmth(math.Abs(cand.Warmth - item.Warmth))
+		if cand.Layer != item.Layer || diff > equivalentWarmthTolerance || o.contains(cand.Name) ||
+			!rs.recommendsAt(cand.Name, o.Context, ageInMonths) {
+			continue
+		}
+		names = append(names, cand.Name)
+	}
+	return names
+}
+
+// recommendsAt はシーン ctx で評価するグループに、月齢 ageInMonths で item を推薦するルールがあるかどうかを返します（気温は問わない）。
+func (rs *RuleSet) recommendsAt(item string, ctx Context, ageInMonths int) bool {
+	for _, g := range rs.Groups {
+		if !g.AppliesTo(ctx) {
+			continue
+		}
+		for _, r := range g.Rules {
+			if r.Age.Contains(float64(ageInMonths)) && slices.Contains(r.Items, item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contains はコーディネートに item が含まれているかどうかを返します。
+func (o LayeredOutfit) contains(item string) bool {
+	return slices.ContainsFunc(o.Items, func(i OutfitItem) bool { return i.Item == item })
+}
+
+// totalWarmth はアイテムの暖かさの合計を返します。
+func totalWarmth(items []OutfitItem) float64 {
+	var sum float64
+	for _, item := range items {
+		sum += item.Warmth
+	}
+	return sum
+}
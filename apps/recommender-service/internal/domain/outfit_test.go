@@ -0,0 +1,178 @@
+package domain_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+// defaultOutfit はデフォルトのルールセットとカタログ、月齢から推定したサイズでコーディネートを組み立てます。
+func defaultOutfit(ctx domain.Context, ageInMonths int, outdoor domain.TemperatureEstimate) domain.LayeredOutfit {
+	return domain.DefaultRuleSet().BuildOutfit(domain.DefaultCatalog(), ctx, ageInMonths, domain.EstimateSize(ageInMonths), outdoor)
+}
+
+func TestBuildOutfit_Default(t *testing.T) {
+	// 6ヶ月・0℃のお出かけ
+	o := defaultOutfit(domain.ContextOuting, 6, domain.TemperatureEstimate{Mean: 0, Min: 0, Max: 0})
+
+	var names []string
+	var warmth float64
+	for i, item := range o.Items {
+		names = append(names, item.Item)
+		warmth += item.Warmth
+		if i > 0 && slices.Index(domain.Layers, item.Layer) < slices.Index(domain.Layers, o.Items[i-1].Layer) {
+			t.Errorf("items are not ordered by layer: %v", names)
+		}
+		if item.Replaces != "" {
+			t.Errorf("%s replaces %s; the default rules should meet the target without replacing", item.Item, item.Replaces)
+		}
+	}
+
+	want := []string{"ボディースーツ", "カバーオール", "ジャンプスーツ", "帽子", "靴下"}
+	if !slices.Equal(names, want) {
+		t.Errorf("items = %v, want %v", names, want)
+	}
+	if math.Abs(o.Warmth-warmth) > 1e-9 || !o.MeetsTarget() {
+		t.Errorf("warmth = %v (sum %v), target = %v; want the sum meeting the target", o.Warmth, warmth, o.TargetWarmth)
+	}
+}
+
+func TestBuildOutfit_Equivalents(t *testing.T) {
+	// 0ヶ月・17℃：ロンパースの代わりにツーウェイオールも着られる
+	o := defaultOutfit(domain.ContextOuting, 0, domain.TemperatureEstimate{Mean: 17, Min: 17, Max: 17})
+
+	for _, item := range o.Items {
+		if item.Item != "ロンパース" {
+			continue
+		}
+		if item.Layer != domain.LayerMiddle {
+			t.Errorf("ロンパース layer = %q, want middle", item.Layer)
+		}
+		if !slices.Equal(item.Equivalents, []string{"ツーウェイオール"}) {
+			t.Errorf("ロンパース equivalents = %v, want [ツーウェイオール]", item.Equivalents)
+		}
+		return
+	}
+	t.Fatalf("outfit should contain ロンパース, got %+v", o.Items)
+}
+
+func TestBuildOutfit_WarmUp(t *testing.T) {
+	catalog, err := domain.ParseCatalog([]byte(`
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+  middle: { label: ミドル }
+items:
+  - { name: 短肌着, layer: inner, warmth: 0.1, shop_names: { nishimatsuya: 短肌着 } }
+  - { name: ロンパース, layer: middle, warmth: 0.25, shop_names: { nishimatsuya: ロンパース } }
+  - { name: カバーオール, layer: middle, warmth: 0.45, shop_names: { nishimatsuya: カバーオール }, sizes: { nishimatsuya: [50, 60, 70] } }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+	// 5℃以上ではロンパースを推薦するルール（カバーオールは5℃未満の1歳未満だけ）
+	rules, err := domain.ParseRuleSet([]byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: inner, items: [短肌着] }
+  - id: layer
+    rules:
+      - { id: romper, age_months: { max: 12 }, temperature: { min: 5 }, items: [ロンパース] }
+      - { id: coverall, age_months: { max: 12 }, temperature: { max: 5 }, items: [カバーオール] }
+      - { id: toddler, age_months: { min: 12 }, items: [ロンパース] }
+`))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		ageInMonths int
+		size        domain.Size // 空なら月齢から推定する
+		temperature float64
+		wantItems   []string
+		wantReplace string
+		wantRuleID  string // 置き換えたアイテムの理由のルール
+		wantMeets   bool
+	}{
+		{
+			name:        "暖かい日はルールのまま",
+			ageInMonths: 6,
+			temperature: 20,
+			wantItems:   []string{"短肌着", "ロンパース"},
+			wantMeets:   true,
+		},
+		{
+			name:        "寒い日は同じレイヤーのより暖かいアイテムに置き換える",
+			ageInMonths: 6,
+			temperature: 12,
+			wantItems:   []string{"短肌着", "カバーオール"},
+			wantReplace: "ロンパース",
+			wantRuleID:  "coverall",
+			wantMeets:   true,
+		},
+		{
+			name:        "置き換えても足りなければそのまま返す",
+			ageInMonths: 6,
+			temperature: 6,
+			wantItems:   []string{"短肌着", "カバーオール"},
+			wantReplace: "ロンパース",
+			wantRuleID:  "coverall",
+			wantMeets:   false,
+		},
+		{
+			name:        "その月齢で推薦されないアイテムには置き換えない",
+			ageInMonths: 12,
+			temperature: -10,
+			wantItems:   []string{"短肌着", "ロンパース"},
+			wantMeets:   false,
+		},
+		{
+			name:        "そのサイズを扱うショップがないアイテムには置き換えない",
+			ageInMonths: 6,
+			size:        domain.Size80,
+			temperature: 12,
+			wantItems:   []string{"短肌着", "ロンパース"},
+			wantMeets:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size := tt.size
+			if size == (domain.Size{}) {
+				size = domain.EstimateSize(tt.ageInMonths)
+			}
+			o := rules.BuildOutfit(catalog, domain.ContextOuting, tt.ageInMonths, size, domain.TemperatureEstimate{Mean: tt.temperature, Min: tt.temperature, Max: tt.temperature})
+
+			var names []string
+			var replaced string
+			for _, item := range o.Items {
+				names = append(names, item.Item)
+				if item.Replaces == "" {
+					continue
+				}
+				replaced = item.Replaces
+				// 理由は置き換え先のアイテムを推薦するルールから作る
+				if item.Reason.RuleID != tt.wantRuleID || item.Reason.TargetWarmth != o.TargetWarmth {
+					t.Errorf("%s: reason = %+v, want rule %s and target warmth %v", item.Item, item.Reason, tt.wantRuleID, o.TargetWarmth)
+				}
+			}
+			if !slices.Equal(names, tt.wantItems) {
+				t.Errorf("items = %v, want %v", names, tt.wantItems)
+			}
+			if replaced != tt.wantReplace {
+				t.Errorf("replaced = %q, want %q", replaced, tt.wantReplace)
+			}
+			if o.MeetsTarget() != tt.wantMeets {
+				t.Errorf("MeetsTarget() = %v (warmth %v, target %v), want %v", o.MeetsTarget(), o.Warmth, o.TargetWarmth, tt.wantMeets)
+			}
+		})
+	}
+}
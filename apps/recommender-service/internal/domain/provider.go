@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TemperatureProvider は地域と日付からその日の気温を推定する仕組みです。
+type TemperatureProvider interface {
+	EstimateTemperature(region Region, date time.Time) TemperatureEstimate
+}
+
+// ClimatologyProvider は地域ごとの平年値から気温を推定する TemperatureProvider です。
+type ClimatologyProvider struct{}
+
+// EstimateTemperature は EstimateRegionalTemperature で平年値ベースの気温を返します。
+func (ClimatologyProvider) EstimateTemperature(region Region, date time.Time) TemperatureEstimate {
+	return EstimateRegionalTemperature(region, date)
+}
+
+// FixedTemperatureProvider は利用者が指定した日平均気温（天気予報の値など）を、どの日付にも使う TemperatureProvider です。
+// 最低・最高気温は、Base で推定したその日の日較差を保ったまま日平均が Mean になるようにずらして求めます。
+type FixedTemperatureProvider struct {
+	Mean float64
+	Base TemperatureProvider
+}
+
+// EstimateTemperature は日平均を Mean に置き換えた気温を返します。
+func (p FixedTemperatureProvider) EstimateTemperature(region Region, date time.Time) TemperatureEstimate {
+	base := p.Base.EstimateTemperature(region, date)
+	shift := p.Mean - base.Mean
+	return TemperatureEstimate{Mean: p.Mean, Min: base.Min + shift, Max: base.Max + shift}
+}
+
+// DailyForecast は予報ファイルの1レコード（ある地域・ある日の気温）です。
+type DailyForecast struct {
+	Date   time.Time
+	Region Region
+	TemperatureEstimate
+}
+
+// forecastKey は予報レコードを引くためのキーです。
+type forecastKey struct {
+	region Region
+	date   string // YYYY-MM-DD
+}
+
+// ForecastProvider は日別の予報データから気温を返す TemperatureProvider です。
+// 予報にない日付（予報期間外の遠い将来など）は Fallback に委ねます。
+type ForecastProvider struct {
+	forecasts map[forecastKey]TemperatureEstimate
+	Fallback  TemperatureProvider
+}
+
+// NewForecastProvider は予報レコードから ForecastProvider を作成します。
+// fallback が nil の場合は ClimatologyProvider を使います。
+// 同じ地域・同じ日付のレコードが複数ある場合（同じ地域の別々の都道府県など）はエラーを返します。
+func NewForecastProvider(records []DailyForecast, fallback TemperatureProvider) (*ForecastProvider, error) {
+	if fallback == nil {
+		fallback = ClimatologyProvider{}
+	}
+	p := &ForecastProvider{
+		forecasts: make(map[forecastKey]TemperatureEstimate, len(records)),
+		Fallback:  fallback,
+	}
+	for _, r := range records {
+		key := forecastKey{region: r.Region, date: r.Date.Format(time.DateOnly)}
+		if _, ok := p.forecasts[key]; ok {
+			return nil, fmt.Errorf("duplicate forecast for region %s on %s", key.region, key.date)
+		}
+		p.forecasts[key] = r.TemperatureEstimate
+	}
+	return p, nil
+}
+
+// EstimateTemperature は予報があれば予報値を、なければ Fallback の推定値を返します。
+func (p *ForecastProvider) EstimateTemperature(region Region, date time.Time) TemperatureEstimate {
+	if est, ok := p.forecasts[forecastKey{region: region, date: date.Format(time.DateOnly)}]; ok {
+		return est
+	}
+	return p.Fallback.EstimateTemperature(region, date)
+}
+
+// LoadForecastFile は CSV または JSON の予報ファイルを読み込んで ForecastProvider を作成します。
+// 形式は拡張子（.csv / .json）で判定します。
+func LoadForecastFile(path string, fallback TemperatureProvider) (*ForecastProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open forecast file: %w", err)
+	}
+	defer f.Close()
+
+	var records []DailyForecast
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		records, err = ParseForecastCSV(f)
+	case ".json":
+		records, err = ParseForecastJSON(f)
+	default:
+		return nil, fmt.Errorf("unsupported forecast file extension: %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse forecast file %s: %w", path, err)
+	}
+	p, err := NewForecastProvider(records, fallback)
+	if err != nil {
+		return nil, fmt.Errorf("load forecast file %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// ParseForecastCSV は気象庁の日別値に近い形式の CSV を読み込みます。
+// ヘッダー行は必須で、date, region, min, max 列（mean 列は任意）を含む必要があります。
+//
+//	date,region,mean,min,max
+//	2026-04-01,tokyo,14.2,9.8,18.9
+//
+// region には地域キー・都道府県キー・都道府県名を指定できます。
+// mean が空の場合は (min+max)/2 を日平均気温とします。
+func ParseForecastCSV(r io.Reader) ([]DailyForecast, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"date", "region", "min", "max"} {
+		if _, ok := cols[required]; !ok {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	var records []DailyForecast
+	for line := 2; ; line++ {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		field := func(name string) string {
+			if i, ok := cols[name]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		record, err := parseForecastRow(field("date"), field("region"), field("mean"), field("min"), field("max"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// forecastJSON は JSON 予報ファイルの1レコードです。
+type forecastJSON struct {
+	Date   string   `json:"date"`
+	Region string   `json:"region"`
+	Mean   *float64 `json:"mean"`
+	Min    *float64 `json:"min"`
+	Max    *float64 `json:"max"`
+}
+
+// ParseForecastJSON は予報レコードの JSON 配列を読み込みます。
+//
+//	[{"date": "2026-04-01", "region": "tokyo", "mean": 14.2, "min": 9.8, "max": 18.9}]
+//
+// min と max は必須です。mean は省略可能で、省略時は (min+max)/2 を日平均気温とします。
+func ParseForecastJSON(r io.Reader) ([]DailyForecast, error) {
+	var raw []forecastJSON
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	records := make([]DailyForecast, 0, len(raw))
+	for i, f := range raw {
+		if f.Min == nil || f.Max == nil {
+			return nil, fmt.Errorf("record %d: min and max are required", i)
+		}
+		record, err := newDailyForecast(f.Date, f.Region, f.Mean, *f.Min, *f.Max)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// parseForecastRow は CSV の1行分の文字列フィールドを数値に変換して DailyForecast を組み立てます。
+func parseForecastRow(date, region, mean, low, high string) (DailyForecast, error) {
+	lo, err := strconv.ParseFloat(low, 64)
+	if err != nil {
+		return DailyForecast{}, fmt.Errorf("invalid min %q: %w", low, err)
+	}
+	hi, err := strconv.ParseFloat(high, 64)
+	if err != nil {
+		return DailyForecast{}, fmt.Errorf("invalid max %q: %w", high, err)
+	}
+	var avg *float64
+	if mean != "" {
+		v, err := strconv.ParseFloat(mean, 64)
+		if err != nil {
+			return DailyForecast{}, fmt.Errorf("invalid mean %q: %w", mean, err)
+		}
+		avg = &v
+	}
+	return newDailyForecast(date, region, avg, lo, hi)
+}
+
+// newDailyForecast は予報レコードを組み立てて検証します。
+// mean が nil の場合は (low+high)/2 を日平均気温とし、指定された場合は low 以上 high 以下である必要があります。
+func newDailyForecast(date, region string, mean *float64, low, high float64) (DailyForecast, error) {
+	d, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return DailyForecast{}, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	r, err := ParseRegion(region)
+	if err != nil {
+		return DailyForecast{}, err
+	}
+	if low > high {
+		return DailyForecast{}, fmt.Errorf("min %v is greater than max %v", low, high)
+	}
+
+	avg := (low + high) / 2
+	if mean != nil {
+		if *mean < low || *mean > high {
+			return DailyForecast{}, fmt.Errorf("mean %v is outside min %v and max %v", *mean, low, high)
+		}
+		avg = *mean
+	}
+
+	return DailyForecast{
+		Date:                d,
+		Region:              r,
+		TemperatureEstimate: TemperatureEstimate{Mean: avg, Min: low, Max: high},
+	}, nil
+}
@@ -0,0 +1,180 @@
+package domain_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestParseForecastCSV(t *testing.T) {
+	input := `date,region,mean,min,max
+2026-04-01,tokyo,14.2,9.8,18.9
+2026-04-02,大阪府,,10.0,20.0
+`
+	records, err := domain.ParseForecastCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseForecastCSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2", len(records))
+	}
+
+	if records[0].Region != domain.RegionKanto || records[0].Mean != 14.2 {
+		t.Errorf("records[0] = %+v, want region=kanto mean=14.2", records[0])
+	}
+	// mean が空の場合は (min+max)/2
+	if records[1].Region != domain.RegionKinki || records[1].Mean != 15.0 {
+		t.Errorf("records[1] = %+v, want region=kinki mean=15.0", records[1])
+	}
+}
+
+func TestParseForecastCSV_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "必須列がない", input: "date,region,mean\n2026-04-01,tokyo,14.2\n"},
+		{name: "日付が不正", input: "date,region,min,max\n2026/04/01,tokyo,9.8,18.9\n"},
+		{name: "地域が不明", input: "date,region,min,max\n2026-04-01,atlantis,9.8,18.9\n"},
+		{name: "最低気温が最高気温より高い", input: "date,region,min,max\n2026-04-01,tokyo,20,10\n"},
+		{name: "日平均気温が最低気温より低い", input: "date,region,mean,min,max\n2026-04-01,tokyo,5,9.8,18.9\n"},
+		{name: "最低気温が空", input: "date,region,min,max\n2026-04-01,tokyo,,18.9\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := domain.ParseForecastCSV(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseForecastCSV should return an error")
+			}
+		})
+	}
+}
+
+func TestParseForecastJSON(t *testing.T) {
+	input := `[
+		{"date": "2026-04-01", "region": "okinawa", "mean": 22.0, "min": 19.5, "max": 25.1},
+		{"date": "2026-04-02", "region": "sapporo", "min": 1.0, "max": 9.0}
+	]`
+	if _, err := domain.ParseForecastJSON(strings.NewReader(input)); err == nil {
+		t.Fatal("ParseForecastJSON should reject unknown region \"sapporo\"")
+	}
+
+	input = strings.Replace(input, `"sapporo"`, `"hokkaido"`, 1)
+	records, err := domain.ParseForecastJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseForecastJSON: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2", len(records))
+	}
+	if records[1].Mean != 5.0 {
+		t.Errorf("records[1].Mean = %v, want 5.0 (average of min/max)", records[1].Mean)
+	}
+}
+
+func TestParseForecastJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "最低気温がない", input: `[{"date": "2026-04-01", "region": "tokyo", "mean": 14.2, "max": 18.9}]`},
+		{name: "最高気温がない", input: `[{"date": "2026-04-01", "region": "tokyo", "mean": 14.2, "min": 9.8}]`},
+		{name: "日平均気温が最高気温より高い", input: `[{"date": "2026-04-01", "region": "tokyo", "mean": 20, "min": 9.8, "max": 18.9}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := domain.ParseForecastJSON(strings.NewReader(tt.input)); err == nil {
+				t.Error("ParseForecastJSON should return an error")
+			}
+		})
+	}
+}
+
+func TestNewForecastProvider_Duplicate(t *testing.T) {
+	// 東京都と神奈川県はどちらも関東甲信に属するため、同じ日付のレコードは衝突する
+	records, err := domain.ParseForecastCSV(strings.NewReader(`date,region,min,max
+2026-04-01,tokyo,9.8,18.9
+2026-04-01,神奈川県,10.5,19.2
+`))
+	if err != nil {
+		t.Fatalf("ParseForecastCSV: %v", err)
+	}
+	if _, err := domain.NewForecastProvider(records, nil); err == nil {
+		t.Error("NewForecastProvider should reject two forecasts for the same region and date")
+	}
+}
+
+func TestForecastProvider_FallsBackToClimatology(t *testing.T) {
+	forecastDay := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+	p, err := domain.NewForecastProvider([]domain.DailyForecast{
+		{
+			Date:                forecastDay,
+			Region:              domain.RegionKanto,
+			TemperatureEstimate: domain.TemperatureEstimate{Mean: 8, Min: 4, Max: 12},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewForecastProvider: %v", err)
+	}
+
+	// 予報がある日は予報値
+	if got := p.EstimateTemperature(domain.RegionKanto, forecastDay); got.Mean != 8 {
+		t.Errorf("forecast day: Mean = %v, want 8", got.Mean)
+	}
+
+	// 予報がない日・地域は平年値
+	otherDay := forecastDay.AddDate(0, 6, 0)
+	if got, want := p.EstimateTemperature(domain.RegionKanto, otherDay), domain.EstimateRegionalTemperature(domain.RegionKanto, otherDay); got != want {
+		t.Errorf("other day: got %+v, want climatology %+v", got, want)
+	}
+	if got, want := p.EstimateTemperature(domain.RegionOkinawa, forecastDay), domain.EstimateRegionalTemperature(domain.RegionOkinawa, forecastDay); got != want {
+		t.Errorf("other region: got %+v, want climatology %+v", got, want)
+	}
+}
+
+func TestLoadForecastFile(t *testing.T) {
+	dir := t.TempDir()
+
+	csvPath := filepath.Join(dir, "forecast.csv")
+	if err := os.WriteFile(csvPath, []byte("date,region,min,max\n2026-04-01,tokyo,2,8\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := domain.LoadForecastFile(csvPath, nil)
+	if err != nil {
+		t.Fatalf("LoadForecastFile(csv): %v", err)
+	}
+	if got := p.EstimateTemperature(domain.RegionKanto, time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)); got.Mean != 5 {
+		t.Errorf("Mean = %v, want 5", got.Mean)
+	}
+
+	txtPath := filepath.Join(dir, "forecast.txt")
+	if err := os.WriteFile(txtPath, []byte(""), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := domain.LoadForecastFile(txtPath, nil); err == nil {
+		t.Error("LoadForecastFile should reject unsupported extensions")
+	}
+}
+
+func TestFixedTemperatureProvider(t *testing.T) {
+	p := domain.FixedTemperatureProvider{Mean: 8, Base: domain.ClimatologyProvider{}}
+
+	// 8月でも指定した気温を使い、日較差は平年値のまま
+	day := time.Date(2026, time.August, 15, 0, 0, 0, 0, time.UTC)
+	got := p.EstimateTemperature(domain.RegionKanto, day)
+	base := domain.EstimateRegionalTemperature(domain.RegionKanto, day)
+	if got.Mean != 8 {
+		t.Errorf("Mean = %v, want 8", got.Mean)
+	}
+	if diff := (got.Max - got.Min) - (base.Max - base.Min); diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("diurnal range = %v, want %v", got.Max-got.Min, base.Max-base.Min)
+	}
+	if got.Min >= got.Mean || got.Max <= got.Mean {
+		t.Errorf("got %+v, want Min < Mean < Max", got)
+	}
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TemperatureBasis はルールの判定にどの気温を使ったかを表します。
+type TemperatureBasis string
+
+const (
+	TemperatureBasisMean TemperatureBasis = "mean" // 日平均気温
+	TemperatureBasisMin  TemperatureBasis = "min"  // 朝晩の最低気温
+)
+
+// Recommendation は推薦アイテムと、それが選ばれた理由です。
+type Recommendation struct {
+	Item   string
+	Reason Reason
+}
+
+// Reason はアイテムが推薦された理由（マッチしたルールと、その月齢・気温の範囲）です。
+type Reason struct {
+	RuleID           string
+	Group            string
+	AgeInMonths      int
+	AgeBand          Band
+	Temperature      float64
+	TemperatureBand  Band
+	TemperatureBasis TemperatureBasis
+	Context          Context // シーンごとのコーディネートで選ばれた場合のシーン
+	TargetWarmth     float64 // 暖かさが目標に届かないために置き換えたアイテムの場合の、目標の暖かさ（clo）。それ以外は 0
+}
+
+// reasonPhrase は理由の文章を組み立てる部品です。
+type reasonPhrase int
+
+const (
+	phraseAge                  reasonPhrase = iota // 月齢の条件（月齢, 範囲）
+	phraseTemperature                              // 気温の条件（気温の呼び方, 気温, 範囲）
+	phraseWarmthTemperature                        // 暖かさのために置き換えた場合の気温（気温の呼び方, 気温）
+	phraseBecause                                  // 条件を並べた文章の全体（条件）
+	phraseShortOfTarget                            // 暖かさのために置き換えた場合の文章の全体（条件, 目標の暖かさ）
+	phraseAlways                                   // 月齢・気温の条件がない場合の文章
+	phraseSeparator                                // 条件の区切り
+	phraseAtLeast                                  // 下限だけの範囲
+	phraseBelow                                    // 上限だけの範囲
+	phraseBetween                                  // 下限と上限のある範囲
+	phraseMonths                                   // 月数の単位
+	phraseEstimatedTemperature                     // 気温の呼び方: 推定気温
+	phraseMinTemperature                           // 気温の呼び方: 朝晩の最低気温
+	phraseRoomTemperature                          // 気温の呼び方: 室温
+	phraseMinRoomTemperature                       // 気温の呼び方: 朝晩の室温
+	phraseBedroomTemperature                       // 気温の呼び方: 寝室の室温
+)
+
+// reasonPhrases は理由の文章の部品の、言語ごとの表現（fmt の書式）です。
+var reasonPhrases = map[reasonPhrase]LocalizedText{
+	phraseAge:               {LangJA: "月齢%dヶ月（%s）", LangEN: "age %d mo (%s)", LangZH: "月龄%d个月（%s）", LangKO: "월령 %d개월(%s)", LangVI: "%d tháng tuổi (%s)"},
+	phraseTemperature:       {LangJA: "%s%s℃（%s）", LangEN: "%s %s℃ (%s)", LangZH: "%s%s℃（%s）", LangKO: "%s %s℃(%s)", LangVI: "%s %s℃ (%s)"},
+	phraseWarmthTemperature: {LangJA: "%s%s℃", LangEN: "%s %s℃", LangZH: "%s%s℃", LangKO: "%s %s℃", LangVI: "%s %s℃"},
+	phraseBecause:           {LangJA: "%sのため", LangEN: "Recommended for %s", LangZH: "适合%s", LangKO: "%s에 맞춘 추천", LangVI: "Phù hợp với %s"},
+	phraseShortOfTarget: {
+		LangJA: "%[1]sで暖かさが目標（%[2]sclo）に届かないため",
+		LangEN: "Added because the outfit falls short of the target warmth (%[2]s clo) at %[1]s",
+		LangZH: "%[1]s时保暖度未达到目标（%[2]s clo），因此添加",
+		LangKO: "%[1]s에서 보온성이 목표(%[2]s clo)에 미치지 못해 추가",
+		LangVI: "Thêm vào vì ở %[1]s độ ấm chưa đạt mục tiêu (%[2]s clo)",
+	},
+	phraseAlways:               {LangJA: "すべての月齢・気温で推奨", LangEN: "Recommended for all ages and temperatures", LangZH: "适用于所有月龄和气温", LangKO: "모든 월령과 기온에 추천", LangVI: "Phù hợp với mọi độ tuổi và nhiệt độ"},
+	phraseSeparator:            {LangJA: "、", LangEN: ", ", LangZH: "，", LangKO: ", ", LangVI: ", "},
+	phraseAtLeast:              {LangJA: "%s以上", LangEN: "%s or above", LangZH: "%s以上", LangKO: "%s 이상", LangVI: "từ %s"},
+	phraseBelow:                {LangJA: "%s未満", LangEN: "below %s", LangZH: "不满%s", LangKO: "%s 미만", LangVI: "dưới %s"},
+	phraseBetween:              {LangJA: "%s以上%s未満", LangEN: "%s to below %s", LangZH: "%s以上不满%s", LangKO: "%s 이상 %s 미만", LangVI: "từ %s đến dưới %s"},
+	phraseMonths:               {LangJA: "ヶ月", LangEN: " mo", LangZH: "个月", LangKO: "개월", LangVI: " tháng"},
+	phraseEstimatedTemperature: {LangJA: "推定気温", LangEN: "estimated temperature", LangZH: "预计气温", LangKO: "예상 기온", LangVI: "nhiệt độ ước tính"},
+	phraseMinTemperature:       {LangJA: "朝晩の最低気温", LangEN: "morning and evening low", LangZH: "早晚最低气温", LangKO: "아침저녁 최저 기온", LangVI: "nhiệt độ thấp nhất sáng tối"},
+	phraseRoomTemperature:      {LangJA: "室温", LangEN: "room temperature", LangZH: "室温", LangKO: "실내 온도", LangVI: "nhiệt độ phòng"},
+	phraseMinRoomTemperature:   {LangJA: "朝晩の室温", LangEN: "morning and evening room temperature", LangZH: "早晚室温", LangKO: "아침저녁 실내 온도", LangVI: "nhiệt độ phòng sáng tối"},
+	phraseBedroomTemperature:   {LangJA: "寝室の室温", LangEN: "bedroom temperature", LangZH: "卧室室温", LangKO: "침실 온도", LangVI: "nhiệt độ phòng ngủ"},
+}
+
+// in は lang での表現を返します。
+func (p reasonPhrase) in(lang Language) string {
+	return reasonPhrases[p].In(lang)
+}
+
+// Message は理由を lang の利用者向けの文章にします。
+// 例（日本語）: "月齢2ヶ月（4ヶ月未満）、推定気温12℃（15℃未満）のため"
+// 暖かさのために置き換えたアイテムでは、気温の範囲の代わりに目標の暖かさを示します。
+// 例（日本語）: "月齢6ヶ月（12ヶ月未満）、推定気温12℃で暖かさが目標（0.6clo）に届かないため"
+func (r Reason) Message(lang Language) string {
+	var parts []string
+	if cond := r.AgeBand.Describe(phraseMonths.in(lang), lang); cond != "" {
+		parts = append(parts, fmt.Sprintf(phraseAge.in(lang), r.AgeInMonths, cond))
+	}
+	sep := phraseSeparator.in(lang)
+	if r.TargetWarmth > 0 {
+		parts = append(parts, fmt.Sprintf(phraseWarmthTemperature.in(lang), r.temperatureLabel().in(lang), formatNumber(r.Temperature)))
+		return fmt.Sprintf(phraseShortOfTarget.in(lang), strings.Join(parts, sep), formatWarmth(r.TargetWarmth))
+	}
+	if cond := r.TemperatureBand.Describe("℃", lang); cond != "" {
+		parts = append(parts, fmt.Sprintf(phraseTemperature.in(lang), r.temperatureLabel().in(lang), formatNumber(r.Temperature), cond))
+	}
+	if len(parts) == 0 {
+		return phraseAlways.in(lang)
+	}
+	return fmt.Sprintf(phraseBecause.in(lang), strings.Join(parts, sep))
+}
+
+// temperatureLabel は判定に使った気温の呼び方です。
+func (r Reason) temperatureLabel() reasonPhrase {
+	switch {
+	case r.Context == ContextSleep:
+		return phraseBedroomTemperature
+	case r.Context == ContextIndoor && r.TemperatureBasis == TemperatureBasisMin:
+		return phraseMinRoomTemperature
+	case r.Context == ContextIndoor:
+		return phraseRoomTemperature
+	case r.TemperatureBasis == TemperatureBasisMin:
+		return phraseMinTemperature
+	}
+	return phraseEstimatedTemperature
+}
+
+// Describe は範囲を lang の「15℃以上20℃未満」のような文章にします。無制限の範囲は空文字を返します。
+func (b Band) Describe(unit string, lang Language) string {
+	switch {
+	case b.Min != nil && b.Max != nil:
+		return fmt.Sprintf(phraseBetween.in(lang), formatNumber(*b.Min)+unit, formatNumber(*b.Max)+unit)
+	case b.Min != nil:
+		return fmt.Sprintf(phraseAtLeast.in(lang), formatNumber(*b.Min)+unit)
+	case b.Max != nil:
+		return fmt.Sprintf(phraseBelow.in(lang), formatNumber(*b.Max)+unit)
+	}
+	return ""
+}
+
+// formatWarmth は暖かさ（clo）を小数第2位で丸めて文字列にします。
+func formatWarmth(v float64) string {
+	return strconv.FormatFloat(roundWarmth(v), 'f', -1, 64)
+}
+
+// formatNumber は小数第1位で丸めて、不要な ".0" を付けずに文字列にします。
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
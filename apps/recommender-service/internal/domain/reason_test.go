@@ -0,0 +1,141 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func ptr(v float64) *float64 { return &v }
+
+func TestBand_Describe(t *testing.T) {
+	tests := []struct {
+		name string
+		band domain.Band
+		want string
+	}{
+		{name: "上限のみ", band: domain.Band{Max: ptr(15)}, want: "15℃未満"},
+		{name: "下限のみ", band: domain.Band{Min: ptr(25)}, want: "25℃以上"},
+		{name: "両方", band: domain.Band{Min: ptr(15), Max: ptr(20)}, want: "15℃以上20℃未満"},
+		{name: "無制限", band: domain.Band{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.band.Describe("℃", domain.LangJA); got != tt.want {
+				t.Errorf("Describe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	recs := domain.DefaultRuleSet().Explain(2, 12)
+
+	var coverall *domain.Recommendation
+	for i := range recs {
+		if recs[i].Item == "カバーオール" {
+			coverall = &recs[i]
+		}
+	}
+	if coverall == nil {
+		t.Fatalf("Explain(2, 12) = %+v, want カバーオール", recs)
+	}
+
+	if coverall.Reason.RuleID != "newborn-cold" {
+		t.Errorf("RuleID = %q, want %q", coverall.Reason.RuleID, "newborn-cold")
+	}
+	want := "月齢2ヶ月（4ヶ月未満）、推定気温12℃（15℃未満）のため"
+	if got := coverall.Reason.Message(domain.LangJA); got != want {
+		t.Errorf("Message() = %q, want %q", got, want)
+	}
+}
+
+// TestExplainForDay_MinBasis は朝晩の冷え込みで追加されたアイテムの理由が最低気温になることを確認します。
+func TestExplainForDay_MinBasis(t *testing.T) {
+	recs := domain.DefaultRuleSet().ExplainForDay(6, domain.TemperatureEstimate{Mean: 23, Min: 18.4, Max: 27})
+
+	for _, rec := range recs {
+		switch rec.Item {
+		case "ボディースーツ":
+			if rec.Reason.TemperatureBasis != domain.TemperatureBasisMean {
+				t.Errorf("ボディースーツ: basis = %q, want mean", rec.Reason.TemperatureBasis)
+			}
+		case "ロンパース":
+			if rec.Reason.TemperatureBasis != domain.TemperatureBasisMin {
+				t.Errorf("ロンパース: basis = %q, want min", rec.Reason.TemperatureBasis)
+			}
+			want := "月齢6ヶ月（4ヶ月以上12ヶ月未満）、朝晩の最低気温18.4℃（15℃以上22℃未満）のため"
+			if got := rec.Reason.Message(domain.LangJA); got != want {
+				t.Errorf("Message() = %q, want %q", got, want)
+			}
+		}
+	}
+}
+
+func TestReason_Message_Context(t *testing.T) {
+	outfit := domain.DefaultRuleSet().Outfit(domain.ContextSleep, 2, domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9})
+
+	for _, rec := range outfit.Recommendations {
+		if rec.Item != "スリーパー" {
+			continue
+		}
+		want := "月齢2ヶ月（12ヶ月未満）、寝室の室温18℃（20℃未満）のため"
+		if got := rec.Reason.Message(domain.LangJA); got != want {
+			t.Errorf("Message() = %q, want %q", got, want)
+		}
+		return
+	}
+	t.Fatalf("sleep outfit should contain スリーパー, got %+v", outfit.Recommendations)
+}
+
+func TestReason_Message_TargetWarmth(t *testing.T) {
+	maxAge := 12.0
+	r := domain.Reason{
+		RuleID:           "coverall",
+		AgeInMonths:      6,
+		AgeBand:          domain.Band{Max: &maxAge},
+		Temperature:      12,
+		TemperatureBasis: domain.TemperatureBasisMean,
+		Context:          domain.ContextOuting,
+		TargetWarmth:     0.625,
+	}
+	want := "月齢6ヶ月（12ヶ月未満）、推定気温12℃で暖かさが目標（0.63clo）に届かないため"
+	if got := r.Message(domain.LangJA); got != want {
+		t.Errorf("Message() = %q, want %q", got, want)
+	}
+}
+
+func TestReason_Message_Language(t *testing.T) {
+	minAge, maxAge, maxTemp := 4.0, 12.0, 15.0
+	r := domain.Reason{
+		RuleID:           "baby-cold",
+		AgeInMonths:      6,
+		AgeBand:          domain.Band{Min: &minAge, Max: &maxAge},
+		Temperature:      12.34,
+		TemperatureBand:  domain.Band{Max: &maxTemp},
+		TemperatureBasis: domain.TemperatureBasisMin,
+	}
+
+	tests := []struct {
+		lang domain.Language
+		want string
+	}{
+		{lang: domain.LangJA, want: "月齢6ヶ月（4ヶ月以上12ヶ月未満）、朝晩の最低気温12.3℃（15℃未満）のため"},
+		{lang: domain.LangEN, want: "Recommended for age 6 mo (4 mo to below 12 mo), morning and evening low 12.3℃ (below 15℃)"},
+		{lang: domain.LangKO, want: "월령 6개월(4개월 이상 12개월 미만), 아침저녁 최저 기온 12.3℃(15℃ 미만)에 맞춘 추천"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.lang), func(t *testing.T) {
+			if got := r.Message(tt.lang); got != tt.want {
+				t.Errorf("Message(%s) = %q, want %q", tt.lang, got, tt.want)
+			}
+		})
+	}
+
+	r.TargetWarmth = 0.625
+	want := "Added because the outfit falls short of the target warmth (0.63 clo) at age 6 mo (4 mo to below 12 mo), morning and evening low 12.3℃"
+	if got := r.Message(domain.LangEN); got != want {
+		t.Errorf("Message(en) with target warmth = %q, want %q", got, want)
+	}
+}
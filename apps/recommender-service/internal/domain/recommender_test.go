@@ -124,6 +124,52 @@ func TestRecommend(t *testing.T) {
 			wantItems:   []string{"ボディースーツ"},
 			wantAbsent:  []string{"短肌着", "コンビ肌着"},
 		},
+
+		// =================================================================
+		// 小物・アウター・ねんね・1歳以降
+		// =================================================================
+		{
+			name:        "新生児 / 快適（22℃）: ツーウェイオール",
+			ageInMonths: 0,
+			temperature: 22,
+			wantItems:   []string{"短肌着", "コンビ肌着", "ツーウェイオール"},
+			wantAbsent:  []string{"カバーオール", "ロンパース", "スリーパー"},
+		},
+		{
+			name:        "高月齢 / 肌寒い（16℃）: ベストとレッグウォーマーで調節",
+			ageInMonths: 8,
+			temperature: 16,
+			wantItems:   []string{"ボディースーツ", "ロンパース", "ベスト", "レッグウォーマー", "スリーパー"},
+			wantAbsent:  []string{"靴下", "ジャンプスーツ"},
+		},
+		{
+			name:        "高月齢 / 極寒（0℃）: 外出用のジャンプスーツと帽子・靴下",
+			ageInMonths: 9,
+			temperature: 0,
+			wantItems:   []string{"カバーオール", "ジャンプスーツ", "帽子", "靴下"},
+			wantAbsent:  []string{"ベスト", "レッグウォーマー"},
+		},
+		{
+			name:        "低月齢 / 極寒（0℃）: ジャンプスーツ・帽子はまだ使わない",
+			ageInMonths: 2,
+			temperature: 0,
+			wantItems:   []string{"靴下", "スリーパー"},
+			wantAbsent:  []string{"ジャンプスーツ", "帽子"},
+		},
+		{
+			name:        "1歳 / 肌寒い（18℃）: セパレートとパジャマ",
+			ageInMonths: 12,
+			temperature: 18,
+			wantItems:   []string{"ボディースーツ", "セパレート", "パジャマ", "スリーパー"},
+			wantAbsent:  []string{"ロンパース", "カバーオール"},
+		},
+		{
+			name:        "1歳半 / 暑い（28℃）: 日よけの帽子",
+			ageInMonths: 18,
+			temperature: 28,
+			wantItems:   []string{"ボディースーツ", "帽子", "パジャマ"},
+			wantAbsent:  []string{"セパレート", "スリーパー"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -156,6 +202,140 @@ func TestRecommend_NeverReturnsNil(t *testing.T) {
 	}
 }
 
+func TestRuleSet_RecommendForDay(t *testing.T) {
+	tests := []struct {
+		name        string
+		ageInMonths int
+		temp        domain.TemperatureEstimate
+		wantItems   []string
+		wantAbsent  []string
+	}{
+		{
+			name:        "日較差が小さい: 日平均だけで決まる",
+			ageInMonths: 2,
+			temp:        domain.TemperatureEstimate{Mean: 21, Min: 20, Max: 22},
+			wantItems:   []string{"短肌着", "コンビ肌着"},
+			wantAbsent:  []string{"ロンパース", "カバーオール"},
+		},
+		{
+			name:        "朝晩が冷える: 最低気温に合わせた重ね着を追加",
+			ageInMonths: 2,
+			temp:        domain.TemperatureEstimate{Mean: 21, Min: 17, Max: 25},
+			wantItems:   []string{"短肌着", "コンビ肌着", "ロンパース"},
+			wantAbsent:  []string{"カバーオール"},
+		},
+		{
+			name:        "日中が暑くてもアイテムは減らさない",
+			ageInMonths: 6,
+			temp:        domain.TemperatureEstimate{Mean: 14, Min: 10, Max: 23},
+			wantItems:   []string{"ボディースーツ", "カバーオール"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.DefaultRuleSet().RecommendForDay(tt.ageInMonths, tt.temp)
+
+			for _, item := range tt.wantItems {
+				if !slices.Contains(got, item) {
+					t.Errorf("RecommendForDay(%d, %+v) = %v, want to contain %q",
+						tt.ageInMonths, tt.temp, got, item)
+				}
+			}
+			for _, item := range tt.wantAbsent {
+				if slices.Contains(got, item) {
+					t.Errorf("RecommendForDay(%d, %+v) = %v, should NOT contain %q",
+						tt.ageInMonths, tt.temp, got, item)
+				}
+			}
+		})
+	}
+}
+
+func TestRuleSet_RecommendFor(t *testing.T) {
+	// 関東の1月頃（外は寒いが、室内は暖房が効いている）
+	winter := domain.TemperatureEstimate{Mean: 5, Min: 1, Max: 9}
+	// 関東の8月頃（夜も暑い）
+	summer := domain.TemperatureEstimate{Mean: 27, Min: 23, Max: 31}
+
+	tests := []struct {
+		name        string
+		ctx         domain.Context
+		ageInMonths int
+		temp        domain.TemperatureEstimate
+		wantItems   []string
+		wantAbsent  []string
+	}{
+		{
+			name:        "冬の室内: 暖房が効いているので外出用の防寒は不要",
+			ctx:         domain.ContextIndoor,
+			ageInMonths: 8,
+			temp:        winter,
+			wantItems:   []string{"ボディースーツ"},
+			wantAbsent:  []string{"カバーオール", "ジャンプスーツ", "帽子", "靴下", "スリーパー"},
+		},
+		{
+			name:        "冬のお出かけ: 外気温に合わせて防寒する",
+			ctx:         domain.ContextOuting,
+			ageInMonths: 8,
+			temp:        winter,
+			wantItems:   []string{"ボディースーツ", "カバーオール", "ジャンプスーツ", "帽子", "靴下"},
+			wantAbsent:  []string{"スリーパー"},
+		},
+		{
+			name:        "冬のねんね: 掛け布団の代わりにスリーパー",
+			ctx:         domain.ContextSleep,
+			ageInMonths: 2,
+			temp:        winter,
+			wantItems:   []string{"短肌着", "コンビ肌着", "ロンパース", "スリーパー"},
+			wantAbsent:  []string{"カバーオール", "帽子", "靴下"},
+		},
+		{
+			name:        "1歳の冬のねんね: パジャマとスリーパー（上下服は着ない）",
+			ctx:         domain.ContextSleep,
+			ageInMonths: 12,
+			temp:        winter,
+			wantItems:   []string{"ボディースーツ", "パジャマ", "スリーパー"},
+			wantAbsent:  []string{"セパレート", "ベスト"},
+		},
+		{
+			name:        "夏のねんね: スリーパーは不要",
+			ctx:         domain.ContextSleep,
+			ageInMonths: 2,
+			temp:        summer,
+			wantItems:   []string{"短肌着", "コンビ肌着"},
+			wantAbsent:  []string{"スリーパー", "ロンパース"},
+		},
+		{
+			name:        "夏のお出かけ: 日よけの帽子",
+			ctx:         domain.ContextOuting,
+			ageInMonths: 6,
+			temp:        summer,
+			wantItems:   []string{"ボディースーツ", "帽子"},
+			wantAbsent:  []string{"パジャマ", "スリーパー"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.DefaultRuleSet().RecommendFor(tt.ctx, tt.ageInMonths, tt.temp)
+
+			for _, item := range tt.wantItems {
+				if !slices.Contains(got, item) {
+					t.Errorf("RecommendFor(%s, %d, %+v) = %v, want to contain %q",
+						tt.ctx, tt.ageInMonths, tt.temp, got, item)
+				}
+			}
+			for _, item := range tt.wantAbsent {
+				if slices.Contains(got, item) {
+					t.Errorf("RecommendFor(%s, %d, %+v) = %v, should NOT contain %q",
+						tt.ctx, tt.ageInMonths, tt.temp, got, item)
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkRecommend(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		domain.Recommend(4, 15.5)
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Region は気候の目安を切り替えるための地域区分です。
+// 気象庁の地方予報区（関東甲信・東海など）をベースにしています。
+type Region string
+
+const (
+	RegionHokkaido Region = "hokkaido"
+	RegionTohoku   Region = "tohoku"
+	RegionKanto    Region = "kanto"
+	RegionHokuriku Region = "hokuriku"
+	RegionTokai    Region = "tokai"
+	RegionKinki    Region = "kinki"
+	RegionChugoku  Region = "chugoku"
+	RegionShikoku  Region = "shikoku"
+	RegionKyushu   Region = "kyushu"
+	RegionOkinawa  Region = "okinawa"
+)
+
+// DefaultRegion は地域の指定がない場合に使う地域です（東京周辺）。
+const DefaultRegion = RegionKanto
+
+// Prefecture は都道府県と、その都道府県が属する地域の対応です。
+type Prefecture struct {
+	Key    string // ローマ字のキー（例: "tokyo"）
+	Name   string // 表示名（例: "東京都"）
+	Region Region
+}
+
+// Prefectures は全47都道府県の一覧です（JIS X 0401 の順）。
+var Prefectures = []Prefecture{
+	{Key: "hokkaido", Name: "北海道", Region: RegionHokkaido},
+	{Key: "aomori", Name: "青森県", Region: RegionTohoku},
+	{Key: "iwate", Name: "岩手県", Region: RegionTohoku},
+	{Key: "miyagi", Name: "宮城県", Region: RegionTohoku},
+	{Key: "akita", Name: "秋田県", Region: RegionTohoku},
+	{Key: "yamagata", Name: "山形県", Region: RegionTohoku},
+	{Key: "fukushima", Name: "福島県", Region: RegionTohoku},
+	{Key: "ibaraki", Name: "茨城県", Region: RegionKanto},
+	{Key: "tochigi", Name: "栃木県", Region: RegionKanto},
+	{Key: "gunma", Name: "群馬県", Region: RegionKanto},
+	{Key: "saitama", Name: "埼玉県", Region: RegionKanto},
+	{Key: "chiba", Name: "千葉県", Region: RegionKanto},
+	{Key: "tokyo", Name: "東京都", Region: RegionKanto},
+	{Key: "kanagawa", Name: "神奈川県", Region: RegionKanto},
+	{Key: "niigata", Name: "新潟県", Region: RegionHokuriku},
+	{Key: "toyama", Name: "富山県", Region: RegionHokuriku},
+	{Key: "ishikawa", Name: "石川県", Region: RegionHokuriku},
+	{Key: "fukui", Name: "福井県", Region: RegionHokuriku},
+	{Key: "yamanashi", Name: "山梨県", Region: RegionKanto},
+	{Key: "nagano", Name: "長野県", Region: RegionKanto},
+	{Key: "gifu", Name: "岐阜県", Region: RegionTokai},
+	{Key: "shizuoka", Name: "静岡県", Region: RegionTokai},
+	{Key: "aichi", Name: "愛知県", Region: RegionTokai},
+	{Key: "mie", Name: "三重県", Region: RegionTokai},
+	{Key: "shiga", Name: "滋賀県", Region: RegionKinki},
+	{Key: "kyoto", Name: "京都府", Region: RegionKinki},
+	{Key: "osaka", Name: "大阪府", Region: RegionKinki},
+	{Key: "hyogo", Name: "兵庫県", Region: RegionKinki},
+	{Key: "nara", Name: "奈良県", Region: RegionKinki},
+	{Key: "wakayama", Name: "和歌山県", Region: RegionKinki},
+	{Key: "tottori", Name: "鳥取県", Region: RegionChugoku},
+	{Key: "shimane", Name: "島根県", Region: RegionChugoku},
+	{Key: "okayama", Name: "岡山県", Region: RegionChugoku},
+	{Key: "hiroshima", Name: "広島県", Region: RegionChugoku},
+	{Key: "yamaguchi", Name: "山口県", Region: RegionChugoku},
+	{Key: "tokushima", Name: "徳島県", Region: RegionShikoku},
+	{Key: "kagawa", Name: "香川県", Region: RegionShikoku},
+	{Key: "ehime", Name: "愛媛県", Region: RegionShikoku},
+	{Key: "kochi", Name: "高知県", Region: RegionShikoku},
+	{Key: "fukuoka", Name: "福岡県", Region: RegionKyushu},
+	{Key: "saga", Name: "佐賀県", Region: RegionKyushu},
+	{Key: "nagasaki", Name: "長崎県", Region: RegionKyushu},
+	{Key: "kumamoto", Name: "熊本県", Region: RegionKyushu},
+	{Key: "oita", Name: "大分県", Region: RegionKyushu},
+	{Key: "miyazaki", Name: "宮崎県", Region: RegionKyushu},
+	{Key: "kagoshima", Name: "鹿児島県", Region: RegionKyushu},
+	{Key: "okinawa", Name: "沖縄県", Region: RegionOkinawa},
+}
+
+// ErrUnknownRegion は地域を解決できない場合のエラーです。
+var ErrUnknownRegion = errors.New("unknown region")
+
+// ParseRegion は地域キー・都道府県キー・都道府県名のいずれかから Region を解決します。
+// 例: "kinki", "osaka", "大阪府", "大阪" はすべて RegionKinki になります。
+func ParseRegion(s string) (Region, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if _, ok := climateProfiles[Region(key)]; ok {
+		return Region(key), nil
+	}
+	for _, p := range Prefectures {
+		if key == p.Key || s == p.Name || s == trimPrefectureSuffix(p.Name) {
+			return p.Region, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownRegion, s)
+}
+
+// trimPrefectureSuffix は「都・府・県」の接尾辞を取り除きます（北海道はそのまま）。
+func trimPrefectureSuffix(name string) string {
+	for _, suffix := range []string{"都", "府", "県"} {
+		if trimmed, ok := strings.CutSuffix(name, suffix); ok {
+			return trimmed
+		}
+	}
+	return name
+}
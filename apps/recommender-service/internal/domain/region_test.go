@@ -0,0 +1,57 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestParseRegion(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  domain.Region
+	}{
+		{name: "地域キー", input: "kinki", want: domain.RegionKinki},
+		{name: "地域キー（大文字・空白）", input: " Okinawa ", want: domain.RegionOkinawa},
+		{name: "都道府県キー", input: "osaka", want: domain.RegionKinki},
+		{name: "都道府県名", input: "北海道", want: domain.RegionHokkaido},
+		{name: "都道府県名（接尾辞つき）", input: "東京都", want: domain.RegionKanto},
+		{name: "都道府県名（接尾辞なし）", input: "鹿児島", want: domain.RegionKyushu},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := domain.ParseRegion(tt.input)
+			if err != nil {
+				t.Fatalf("ParseRegion(%q) error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRegion(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRegion_Unknown(t *testing.T) {
+	if _, err := domain.ParseRegion("atlantis"); !errors.Is(err, domain.ErrUnknownRegion) {
+		t.Errorf("ParseRegion(\"atlantis\") error = %v, want ErrUnknownRegion", err)
+	}
+}
+
+// TestPrefectures_AllRegionsHaveProfiles は、すべての都道府県が気候プロファイルのある地域に属していることを確認します。
+func TestPrefectures_AllRegionsHaveProfiles(t *testing.T) {
+	if len(domain.Prefectures) != 47 {
+		t.Errorf("len(Prefectures) = %d, want 47", len(domain.Prefectures))
+	}
+	for _, p := range domain.Prefectures {
+		profile := domain.ClimateProfileFor(p.Region)
+		if profile.Region != p.Region {
+			t.Errorf("prefecture %q: region %q has no climate profile", p.Key, p.Region)
+		}
+		if len(profile.MonthlyAverageTemp) != 12 {
+			t.Errorf("region %q: len(MonthlyAverageTemp) = %d, want 12", p.Region, len(profile.MonthlyAverageTemp))
+		}
+	}
+}
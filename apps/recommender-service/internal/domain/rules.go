@@ -0,0 +1,353 @@
+package domain
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed rules/default.yaml
+var defaultRulesYAML []byte
+
+// Band は min 以上 max 未満の範囲です。nil の側は無制限を表します。
+type Band struct {
+	Min *float64 `yaml:"min"`
+	Max *float64 `yaml:"max"`
+}
+
+// Contains は v がこの範囲に含まれるかどうかを返します。
+func (b Band) Contains(v float64) bool {
+	return (b.Min == nil || v >= *b.Min) && (b.Max == nil || v < *b.Max)
+}
+
+// Rule は「月齢 × 気温」の範囲と、その範囲で推薦するアイテムの組です。
+type Rule struct {
+	ID          string   `yaml:"id"`
+	Age         Band     `yaml:"age_months"`
+	Temperature Band     `yaml:"temperature"`
+	Items       []string `yaml:"items"`
+}
+
+// RuleGroup は独立に評価されるルールのまとまり（インナー、重ね着など）です。
+// 1つのグループのルールは「月齢 × 気温」の全範囲を隙間・重なりなく覆います。
+// Contexts を指定したグループは、そのシーンのコーディネートでだけ評価されます（省略するとすべてのシーン）。
+type RuleGroup struct {
+	ID       string    `yaml:"id"`
+	Label    string    `yaml:"label"`
+	Contexts []Context `yaml:"contexts"`
+	Rules    []Rule    `yaml:"rules"`
+}
+
+// AppliesTo はグループがシーン ctx で評価されるかどうかを返します。ctx が空の場合はすべてのグループが評価されます。
+func (g RuleGroup) AppliesTo(ctx Context) bool {
+	return ctx == "" || len(g.Contexts) == 0 || slices.Contains(g.Contexts, ctx)
+}
+
+// RuleSet は推薦ルールの全体です。
+type RuleSet struct {
+	Version          int                    `yaml:"version"`
+	Groups           []RuleGroup            `yaml:"groups"`
+	Substitutions    []Substitution         `yaml:"substitutions"`     // 代わりのコーディネートを作るための置き換え表（任意）
+	TemperatureBands []TemperatureBandBound `yaml:"temperature_bands"` // 気温の体感の区分（低い順、任意）
+}
+
+// defaultRuleSet は埋め込みのデフォルトルールを一度だけ読み込みます。
+var defaultRuleSet = sync.OnceValue(func() *RuleSet {
+	rs, err := ParseRuleSet(defaultRulesYAML)
+	if err != nil {
+		panic(fmt.Sprintf("domain: invalid default rule set: %v", err))
+	}
+	return rs
+})
+
+// DefaultRuleSet は組み込みのデフォルトルールを返します。
+func DefaultRuleSet() *RuleSet {
+	return defaultRuleSet()
+}
+
+// LoadRuleSet はルールファイル（YAML または JSON）を読み込み、検証して返します。
+func LoadRuleSet(path string) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read rule file: %w", err)
+	}
+	rs, err := ParseRuleSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("rule file %s: %w", path, err)
+	}
+	return rs, nil
+}
+
+// ParseRuleSet はルール定義（YAML または JSON）を解析し、検証して返します。
+func ParseRuleSet(data []byte) (*RuleSet, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var rs RuleSet
+	if err := dec.Decode(&rs); err != nil {
+		return nil, fmt.Errorf("parse rule set: %w", err)
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// Validate はルールセットの整合性を検証します。
+//   - ID が空でなく、一意であること
+//   - 各範囲が min < max であること
+//   - グループのシーン（contexts）が既知のものであること
+//   - 各グループが「月齢(0以上) × 気温」の全範囲を隙間・重なりなく覆うこと
+//   - 置き換え表が正しいこと（validateSubstitutions）
+//   - 気温の区分が正しいこと（validateTemperatureBands）
+//
+// アイテムがカタログに存在するかどうかは ValidateItems で検証します。
+func (rs *RuleSet) Validate() error {
+	var errs []error
+	if len(rs.Groups) == 0 {
+		errs = append(errs, errors.New("rule set has no groups"))
+	}
+
+	ruleIDs := map[string]bool{}
+	groupIDs := map[string]bool{}
+	for _, g := range rs.Groups {
+		if g.ID == "" {
+			errs = append(errs, errors.New("group id is empty"))
+		} else if groupIDs[g.ID] {
+			errs = append(errs, fmt.Errorf("duplicate group id %q", g.ID))
+		}
+		groupIDs[g.ID] = true
+
+		for _, ctx := range g.Contexts {
+			if _, err := ParseContext(string(ctx)); err != nil {
+				errs = append(errs, fmt.Errorf("group %q: %w", g.ID, err))
+			}
+		}
+
+		for _, r := range g.Rules {
+			if r.ID == "" {
+				errs = append(errs, fmt.Errorf("group %q: rule id is empty", g.ID))
+			} else if ruleIDs[r.ID] {
+				errs = append(errs, fmt.Errorf("duplicate rule id %q", r.ID))
+			}
+			ruleIDs[r.ID] = true
+
+			if !validBand(r.Age) {
+				errs = append(errs, fmt.Errorf("rule %q: age_months min must be less than max", r.ID))
+			}
+			if !validBand(r.Temperature) {
+				errs = append(errs, fmt.Errorf("rule %q: temperature min must be less than max", r.ID))
+			}
+		}
+
+		errs = append(errs, g.validateCoverage()...)
+	}
+	errs = append(errs, rs.validateSubstitutions()...)
+	errs = append(errs, rs.validateTemperatureBands()...)
+	return errors.Join(errs...)
+}
+
+// ValidateItems はルールで推薦するアイテムと置き換え表のアイテムがすべてカタログに存在することを検証します。
+func (rs *RuleSet) ValidateItems(c *Catalog) error {
+	var errs []error
+	for _, g := range rs.Groups {
+		for _, r := range g.Rules {
+			for _, item := range r.Items {
+				if _, ok := c.Item(item); !ok {
+					errs = append(errs, fmt.Errorf("rule %q: unknown item %q", r.ID, item))
+				}
+			}
+		}
+	}
+	for i, s := range rs.Substitutions {
+		for _, item := range slices.Concat(s.From, s.To) {
+			if _, ok := c.Item(item); !ok {
+				errs = append(errs, fmt.Errorf("substitution %d: unknown item %q", i, item))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validBand は min と max の両方が指定されている場合に min < max であることを確認します。
+func validBand(b Band) bool {
+	return b.Min == nil || b.Max == nil || *b.Min < *b.Max
+}
+
+// validateCoverage はグループのルールが「月齢 × 気温」を隙間・重なりなく覆っているかを検証します。
+// すべてのルールの境界値で平面をセルに分割し、各セルの代表点にマッチするルールがちょうど1つであることを確認します。
+func (g RuleGroup) validateCoverage() []error {
+	ages := []float64{0}
+	var temps []float64
+	for _, r := range g.Rules {
+		for _, v := range []*float64{r.Age.Min, r.Age.Max} {
+			if v != nil && *v > 0 {
+				ages = append(ages, *v)
+			}
+		}
+		for _, v := range []*float64{r.Temperature.Min, r.Temperature.Max} {
+			if v != nil {
+				temps = append(temps, *v)
+			}
+		}
+	}
+
+	var errs []error
+	for _, age := range samplePoints(ages, false) {
+		for _, temp := range samplePoints(temps, true) {
+			var matched []string
+			for _, r := range g.Rules {
+				if r.Age.Contains(age) && r.Temperature.Contains(temp) {
+					matched = append(matched, r.ID)
+				}
+			}
+			switch {
+			case len(matched) == 0:
+				errs = append(errs, fmt.Errorf("group %q: no rule covers age=%vm temperature=%v℃", g.ID, age, temp))
+			case len(matched) > 1:
+				errs = append(errs, fmt.Errorf("group %q: rules %v overlap at age=%vm temperature=%v℃", g.ID, matched, age, temp))
+			}
+		}
+	}
+	return errs
+}
+
+// samplePoints は境界値で区切られた各区間から代表点を1つずつ返します。
+// withLower が true の場合は最小の境界より下の区間も含めます。
+func samplePoints(bounds []float64, withLower bool) []float64 {
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+	if len(bounds) == 0 {
+		return []float64{0}
+	}
+
+	var points []float64
+	if withLower {
+		points = append(points, bounds[0]-1)
+	}
+	for i, b := range bounds {
+		if i+1 < len(bounds) {
+			points = append(points, b+(bounds[i+1]-b)/2)
+		} else {
+			points = append(points, b+1)
+		}
+	}
+	return points
+}
+
+// Recommend はルールを評価して、推奨されるベビー服の universal_name のリストを返します。
+// すべてのシーンのグループを同じ気温で評価するため、その時期に用意しておきたいアイテムの一覧になります。
+func (rs *RuleSet) Recommend(ageInMonths int, temperature float64) []string {
+	return itemNames(rs.Explain(ageInMonths, temperature))
+}
+
+// RecommendForDay は1日の気温の幅を考慮して推奨アイテムを返します。
+func (rs *RuleSet) RecommendForDay(ageInMonths int, temp TemperatureEstimate) []string {
+	return itemNames(rs.ExplainForDay(ageInMonths, temp))
+}
+
+// Explain はルールを評価して、推奨アイテムとそれぞれが選ばれた理由を返します。
+func (rs *RuleSet) Explain(ageInMonths int, temperature float64) []Recommendation {
+	return rs.explain(ageInMonths, temperature, TemperatureBasisMean, "")
+}
+
+// ExplainForDay は1日の気温の幅を考慮して、推奨アイテムとそれぞれが選ばれた理由を返します。
+// 日平均気温で選んだアイテムに、朝晩（最低気温）の冷え込みで必要になる重ね着を追加します。
+// 日中の暑い時間帯は脱がせて調整できるため、最高気温側ではアイテムを減らしません。
+func (rs *RuleSet) ExplainForDay(ageInMonths int, temp TemperatureEstimate) []Recommendation {
+	return rs.explainForDay(ageInMonths, temp, "")
+}
+
+// Outfit はあるシーンのコーディネートです。
+type Outfit struct {
+	Context         Context
+	Temperature     TemperatureEstimate // そのシーンで過ごす場所の気温（Context.EffectiveTemperature）
+	Recommendations []Recommendation
+}
+
+// RecommendFor はシーン ctx のコーディネートのアイテムの universal_name のリストを返します。
+func (rs *RuleSet) RecommendFor(ctx Context, ageInMonths int, outdoor TemperatureEstimate) []string {
+	return itemNames(rs.Outfit(ctx, ageInMonths, outdoor).Recommendations)
+}
+
+// Outfit はシーン ctx のコーディネートを返します。
+// 外気温からそのシーンの気温を求め、そのシーンで評価するグループのルールだけを ExplainForDay と同じ方法で評価します。
+func (rs *RuleSet) Outfit(ctx Context, ageInMonths int, outdoor TemperatureEstimate) Outfit {
+	temp := ctx.EffectiveTemperature(outdoor)
+	return Outfit{
+		Context:         ctx,
+		Temperature:     temp,
+		Recommendations: rs.explainForDay(ageInMonths, temp, ctx),
+	}
+}
+
+// explainForDay は ctx で評価するグループについて ExplainForDay を行います。ctx が空の場合はすべてのグループを評価します。
+func (rs *RuleSet) explainForDay(ageInMonths int, temp TemperatureEstimate, ctx Context) []Recommendation {
+	recs := rs.explain(ageInMonths, temp.Mean, TemperatureBasisMean, ctx)
+	for _, rec := range rs.explain(ageInMonths, temp.Min, TemperatureBasisMin, ctx) {
+		if !slices.ContainsFunc(recs, func(r Recommendation) bool { return r.Item == rec.Item }) {
+			recs = append(recs, rec)
+		}
+	}
+	return recs
+}
+
+func (rs *RuleSet) explain(ageInMonths int, temperature float64, basis TemperatureBasis, ctx Context) []Recommendation {
+	recs := []Recommendation{}
+	for _, g := range rs.Groups {
+		if !g.AppliesTo(ctx) {
+			continue
+		}
+		for _, r := range g.Rules {
+			if !r.Age.Contains(float64(ageInMonths)) || !r.Temperature.Contains(temperature) {
+				continue
+			}
+			for _, item := range r.Items {
+				recs = append(recs, Recommendation{
+					Item: item,
+					Reason: Reason{
+						RuleID:           r.ID,
+						Group:            g.ID,
+						AgeInMonths:      ageInMonths,
+						AgeBand:          r.Age,
+						Temperature:      temperature,
+						TemperatureBand:  r.Temperature,
+						TemperatureBasis: basis,
+						Context:          ctx,
+					},
+				})
+			}
+			break
+		}
+	}
+	return recs
+}
+
+// RulesRecommending はアイテムを推薦するルールをグループの順に返します。
+// アイテムはそれぞれのルールの月齢・気温の範囲で推薦されます（範囲どうしの間には推薦されない区間があり得ます）。
+func (rs *RuleSet) RulesRecommending(item string) []Rule {
+	var rules []Rule
+	for _, g := range rs.Groups {
+		for _, r := range g.Rules {
+			if slices.Contains(r.Items, item) {
+				rules = append(rules, r)
+			}
+		}
+	}
+	return rules
+}
+
+// itemNames は推薦結果から universal_name のリストを取り出します。
+func itemNames(recs []Recommendation) []string {
+	items := make([]string, 0, len(recs))
+	for _, rec := range recs {
+		items = append(items, rec.Item)
+	}
+	return items
+}
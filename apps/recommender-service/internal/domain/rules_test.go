@@ -0,0 +1,351 @@
+package domain_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+// TestDefaultRuleSet_IsValid は組み込みのデフォルトルールが検証を通ることを確認します。
+func TestDefaultRuleSet_IsValid(t *testing.T) {
+	if err := domain.DefaultRuleSet().Validate(); err != nil {
+		t.Fatalf("default rule set is invalid: %v", err)
+	}
+}
+
+func TestParseRuleSet_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name: "気温に隙間がある",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: cold
+        temperature: { max: 15 }
+        items: [短肌着]
+      - id: warm
+        temperature: { min: 20 }
+        items: [短肌着]
+`,
+			wantErr: "no rule covers",
+		},
+		{
+			name: "月齢が重なっている",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: newborn
+        age_months: { max: 6 }
+        items: [短肌着]
+      - id: infant
+        age_months: { min: 4 }
+        items: [ボディースーツ]
+`,
+			wantErr: "overlap",
+		},
+		{
+			name: "月齢0から始まっていない",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: infant
+        age_months: { min: 4 }
+        items: [ボディースーツ]
+`,
+			wantErr: "no rule covers",
+		},
+		{
+			name: "min が max 以上",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: broken
+        temperature: { min: 20, max: 10 }
+        items: [短肌着]
+`,
+			wantErr: "min must be less than max",
+		},
+		{
+			name: "ルール ID の重複",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: dup
+        age_months: { max: 4 }
+        items: [短肌着]
+      - id: dup
+        age_months: { min: 4 }
+        items: [ボディースーツ]
+`,
+			wantErr: "duplicate rule id",
+		},
+		{
+			name: "未知のフィールド（typo）",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: all
+        temprature: { max: 15 }
+        items: [短肌着]
+`,
+			wantErr: "temprature",
+		},
+		{
+			name: "未知のシーン",
+			input: `
+version: 1
+groups:
+  - id: inner
+    contexts: [bath]
+    rules:
+      - id: all
+        items: [短肌着]
+`,
+			wantErr: "unknown context",
+		},
+		{
+			name: "置き換え先が空",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: all
+        items: [短肌着]
+substitutions:
+  - from: [短肌着]
+    to: []
+`,
+			wantErr: "from and to must not be empty",
+		},
+		{
+			name: "置き換え先がその月齢でルールに推薦されない",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: newborn
+        age_months: { max: 4 }
+        items: [短肌着]
+      - id: infant
+        age_months: { min: 4 }
+        items: [ボディースーツ]
+substitutions:
+  - from: [短肌着]
+    to: [ボディースーツ]
+    age_months: { max: 4 }
+`,
+			wantErr: "not recommended by any rule",
+		},
+		{
+			name: "気温の区分の上限がルールの境界と揃っていない",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: cold, temperature: { max: 15 }, items: [短肌着] }
+      - { id: warm, temperature: { min: 15 }, items: [] }
+temperature_bands:
+  - { band: cold, max: 12 }
+  - { band: hot }
+`,
+			wantErr: "not a temperature boundary",
+		},
+		{
+			name: "気温の区分の上限が低い順でない",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: cold, temperature: { max: 10 }, items: [短肌着] }
+      - { id: cool, temperature: { min: 10, max: 20 }, items: [] }
+      - { id: warm, temperature: { min: 20 }, items: [] }
+temperature_bands:
+  - { band: cool, max: 20 }
+  - { band: cold, max: 10 }
+  - { band: hot }
+`,
+			wantErr: "must be greater than the previous band",
+		},
+		{
+			name: "最後の気温の区分に上限がある",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: cold, temperature: { max: 15 }, items: [短肌着] }
+      - { id: warm, temperature: { min: 15 }, items: [] }
+temperature_bands:
+  - { band: cold, max: 15 }
+`,
+			wantErr: "must not have max",
+		},
+		{
+			name: "未知の気温の区分",
+			input: `
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: all, items: [短肌着] }
+temperature_bands:
+  - { band: freezing }
+`,
+			wantErr: "unknown band",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.ParseRuleSet([]byte(tt.input))
+			if err == nil {
+				t.Fatal("ParseRuleSet should return an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %q, want to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestParseRuleSet_JSON は JSON 形式のルールも読み込めることを確認します。
+func TestParseRuleSet_JSON(t *testing.T) {
+	input := `{
+		"version": 1,
+		"groups": [{
+			"id": "inner",
+			"rules": [
+				{"id": "cold", "temperature": {"max": 10}, "items": ["短肌着", "コンビ肌着"]},
+				{"id": "warm", "temperature": {"min": 10}, "items": ["短肌着"]}
+			]
+		}]
+	}`
+	rs, err := domain.ParseRuleSet([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+
+	if got := rs.Recommend(0, 5); !slices.Equal(got, []string{"短肌着", "コンビ肌着"}) {
+		t.Errorf("Recommend(0, 5) = %v", got)
+	}
+	if got := rs.Recommend(30, 10); !slices.Equal(got, []string{"短肌着"}) {
+		t.Errorf("Recommend(30, 10) = %v", got)
+	}
+}
+
+// TestRuleSet_ValidateItems はカタログにないアイテムを推薦するルールを検出できることを確認します。
+func TestRuleSet_ValidateItems(t *testing.T) {
+	rs, err := domain.ParseRuleSet([]byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: all
+        items: [宇宙服]
+`))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+
+	err = rs.ValidateItems(domain.DefaultCatalog())
+	if err == nil || !strings.Contains(err.Error(), "unknown item") {
+		t.Errorf("ValidateItems = %v, want an unknown item error", err)
+	}
+
+	// 置き換え表のアイテムも検証する
+	rs, err = domain.ParseRuleSet([]byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: all
+        items: [短肌着]
+substitutions:
+  - from: [宇宙服]
+    to: [短肌着]
+`))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+	err = rs.ValidateItems(domain.DefaultCatalog())
+	if err == nil || !strings.Contains(err.Error(), `substitution 0: unknown item "宇宙服"`) {
+		t.Errorf("ValidateItems = %v, want an unknown item error for the substitution", err)
+	}
+}
+
+func TestLoadRuleSet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - id: all
+        items: [ボディースーツ]
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := domain.LoadRuleSet(path)
+	if err != nil {
+		t.Fatalf("LoadRuleSet: %v", err)
+	}
+	if got := rs.Recommend(0, 30); !slices.Equal(got, []string{"ボディースーツ"}) {
+		t.Errorf("Recommend(0, 30) = %v, want [ボディースーツ]", got)
+	}
+
+	if _, err := domain.LoadRuleSet(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("LoadRuleSet should fail for a missing file")
+	}
+}
+
+func TestRuleSet_RulesRecommending(t *testing.T) {
+	rs := domain.DefaultRuleSet()
+
+	tests := []struct {
+		item      string
+		wantIDs   []string
+		wantTemps []string // ルールごとの気温の範囲（Describe）
+	}{
+		{item: "短肌着", wantIDs: []string{"newborn-inner", "newborn-inner-hot"}, wantTemps: []string{"25℃未満", "25℃以上"}},
+		{item: "ロンパース", wantIDs: []string{"newborn-cool", "infant-cool"}, wantTemps: []string{"15℃以上20℃未満", "15℃以上22℃未満"}},
+		{item: "セパレート", wantIDs: []string{"toddler-cool"}, wantTemps: []string{"22℃未満"}},
+		// 寒い日と暑い日の2つの範囲で、その間（10〜25℃）は含まない
+		{item: "帽子", wantIDs: []string{"hat-cold", "hat-sun"}, wantTemps: []string{"10℃未満", "25℃以上"}},
+		{item: "宇宙服"},
+	}
+	for _, tt := range tests {
+		var ids, temps []string
+		for _, r := range rs.RulesRecommending(tt.item) {
+			ids = append(ids, r.ID)
+			temps = append(temps, r.Temperature.Describe("℃", domain.LangJA))
+		}
+		if !slices.Equal(ids, tt.wantIDs) || !slices.Equal(temps, tt.wantTemps) {
+			t.Errorf("RulesRecommending(%q) = %v %v, want %v %v", tt.item, ids, temps, tt.wantIDs, tt.wantTemps)
+		}
+	}
+}
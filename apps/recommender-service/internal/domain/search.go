@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// 検索のスコアの重みとしきい値です。
+const (
+	// categoryMatchWeight はカテゴリー名で一致したときにスコアに掛ける重みです（名前での一致を優先する）。
+	categoryMatchWeight = 0.8
+	// containsMatchScore はクエリが名前の一部に含まれるときのスコアです。
+	containsMatchScore = 0.9
+	// minContainsRatio は部分一致のスコアを長さの比で割り引くときの比の下限です。
+	minContainsRatio = 0.6
+	// minPartialMatchLength は部分一致・あいまい一致を認める最小の文字数です。
+	// これより短いクエリ（1文字など）は、どの名前にも部分的に一致しやすいため完全一致だけを返します。
+	minPartialMatchLength = 2
+	// minSearchScore は検索結果に含めるスコアの下限です。
+	minSearchScore = 0.5
+)
+
+// searchEntry は検索対象の名前（汎用名・読み・翻訳名・ショップ固有名・カテゴリー名）の1件です。
+type searchEntry struct {
+	item   int     // Catalog.Items の添字
+	text   string  // 元の表記
+	norm   string  // NormalizeName で正規化した表記
+	shop   string  // ショップ固有名の場合はショップのキー
+	weight float64 // スコアに掛ける重み
+}
+
+// SearchResult は検索結果の1件（アイテムごと）です。
+type SearchResult struct {
+	Item        CatalogItem
+	Score       float64 // 0〜1（1 が完全一致）
+	MatchedText string  // 最もよく一致した名前
+	MatchedShop string  // ショップ固有名で一致した場合のショップのキー
+}
+
+// buildSearchIndex は検索対象の名前を正規化して保持します。
+func (c *Catalog) buildSearchIndex() {
+	c.search = nil
+	add := func(item int, text, shop string, weight float64) {
+		if n := NormalizeName(text); n != "" {
+			c.search = append(c.search, searchEntry{item: item, text: text, norm: n, shop: shop, weight: weight})
+		}
+	}
+	for i, item := range c.Items {
+		add(i, item.Name, "", 1)
+		for _, r := range item.Readings {
+			add(i, r, "", 1)
+		}
+		for _, lang := range SupportedLanguages {
+			add(i, item.Names[lang], "", 1)
+		}
+		for _, shop := range c.Shops {
+			add(i, item.ShopNames[shop.Key], shop.Key, 1)
+		}
+		add(i, c.Categories[item.Category].Label, "", categoryMatchWeight)
+	}
+}
+
+// Search はアイテムの汎用名・読み・翻訳名・ショップ固有名・カテゴリー名からクエリに近いアイテムを探します。
+// クエリと名前はどちらも NormalizeName で正規化してから比べるため、
+// 全角/半角、ひらがな/カタカナ、長音記号、小書きのカナの違いは無視されます。
+// 完全一致・部分一致のほか、編集距離とバイグラムの類似度のよい方でスコアを付け（1文字のクエリは完全一致のみ）、
+// スコアの高い順（同点はカタログの順）に最大 limit 件を返します。
+func (c *Catalog) Search(query string, limit int) []SearchResult {
+	q := NormalizeName(query)
+	if q == "" || limit <= 0 {
+		return nil
+	}
+
+	best := map[int]SearchResult{}
+	for _, e := range c.search {
+		score := similarity(q, e.norm) * e.weight
+		if score < minSearchScore {
+			continue
+		}
+		if cur, ok := best[e.item]; ok && cur.Score >= score {
+			continue
+		}
+		best[e.item] = SearchResult{Item: c.Items[e.item], Score: score, MatchedText: e.text, MatchedShop: e.shop}
+	}
+
+	results := make([]SearchResult, 0, len(best))
+	for _, r := range best {
+		results = append(results, r)
+	}
+	slices.SortFunc(results, func(a, b SearchResult) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(c.index[a.Item.Name], c.index[b.Item.Name]))
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// similarity は正規化済みの2つの文字列の類似度（0〜1）を返します。
+func similarity(query, target string) float64 {
+	q, t := []rune(query), []rune(target)
+	switch {
+	case query == target:
+		return 1
+	case min(len(q), len(t)) < minPartialMatchLength:
+		return 0
+	case strings.Contains(target, query) || strings.Contains(query, target):
+		// 名前のごく一部だけの一致ほど下位に並ぶよう、長さの比で割り引く
+		ratio := float64(min(len(q), len(t))) / float64(max(len(q), len(t)))
+		return containsMatchScore * max(ratio, minContainsRatio)
+	}
+	return max(editSimilarity(query, target), bigramSimilarity(query, target))
+}
+
+// editSimilarity は編集距離（レーベンシュタイン距離）を長い方の文字数で割った値を 1 から引いたものです。
+func editSimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	n := max(len(ra), len(rb))
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+// levenshtein は2つの文字列の編集距離を返します。
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// bigramSimilarity は文字バイグラムの Dice 係数を返します。
+func bigramSimilarity(a, b string) float64 {
+	ga, gb := bigrams(a), bigrams(b)
+	if len(ga) == 0 || len(gb) == 0 {
+		return 0
+	}
+	counts := map[string]int{}
+	for _, g := range ga {
+		counts[g]++
+	}
+	common := 0
+	for _, g := range gb {
+		if counts[g] > 0 {
+			counts[g]--
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(ga)+len(gb))
+}
+
+// bigrams は文字列を2文字ずつの組に分割します。
+func bigrams(s string) []string {
+	r := []rune(s)
+	if len(r) < 2 {
+		return nil
+	}
+	grams := make([]string, 0, len(r)-1)
+	for i := 0; i+1 < len(r); i++ {
+		grams = append(grams, string(r[i:i+2]))
+	}
+	return grams
+}
@@ -0,0 +1,64 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestCatalog_Search(t *testing.T) {
+	catalog := domain.DefaultCatalog()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "こんびはだぎ", want: "コンビ肌着"},
+		{query: "ﾛﾝﾊﾟｰｽ", want: "ロンパース"},
+		{query: "ぼでぃーすーつ", want: "ボディースーツ"},
+		{query: "プレオール", want: "カバーオール"},
+		{query: "カバーオル", want: "カバーオール"},
+		{query: "たんはだき", want: "短肌着"},
+		{query: "長袖ボディシャツ", want: "ボディースーツ"},
+	}
+	for _, tt := range tests {
+		results := catalog.Search(tt.query, 10)
+		if len(results) == 0 {
+			t.Errorf("Search(%q) returned no results, want %q", tt.query, tt.want)
+			continue
+		}
+		if got := results[0].Item.Name; got != tt.want {
+			t.Errorf("Search(%q)[0] = %q (score %.2f), want %q", tt.query, got, results[0].Score, tt.want)
+		}
+	}
+}
+
+func TestCatalog_Search_Ranking(t *testing.T) {
+	results := domain.DefaultCatalog().Search("はだぎ", 10)
+	if len(results) < 2 {
+		t.Fatalf("Search(はだぎ) = %d results, want both 肌着 items", len(results))
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i-1].Score < results[i].Score {
+			t.Errorf("results are not sorted by score: %v then %v", results[i-1].Score, results[i].Score)
+		}
+	}
+
+	if got := domain.DefaultCatalog().Search("はだぎ", 1); len(got) != 1 {
+		t.Errorf("Search with limit 1 returned %d results", len(got))
+	}
+	if got := domain.DefaultCatalog().Search("ぐらんどぴあの", 10); len(got) != 0 {
+		t.Errorf("Search(ぐらんどぴあの) = %+v, want no results", got)
+	}
+}
+
+func TestCatalog_Search_SingleCharacter(t *testing.T) {
+	// 1文字のクエリはほとんどの名前に部分一致するため、完全一致だけを返す
+	for _, query := range []string{"a", "ス", "オ", "肌"} {
+		for _, r := range domain.DefaultCatalog().Search(query, 10) {
+			if r.Score != 1 {
+				t.Errorf("Search(%q) returned %q (score %.2f), want no partial matches", query, r.MatchedText, r.Score)
+			}
+		}
+	}
+}
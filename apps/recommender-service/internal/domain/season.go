@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Season は季節です。気象庁の区分（春: 3〜5月、夏: 6〜8月、秋: 9〜11月、冬: 12〜2月）に、
+// 地域ごとの梅雨の時期を加えたものです。
+type Season string
+
+const (
+	SeasonSpring Season = "spring"
+	SeasonRainy  Season = "rainy_season" // 梅雨
+	SeasonSummer Season = "summer"
+	SeasonAutumn Season = "autumn"
+	SeasonWinter Season = "winter"
+)
+
+// seasonLabels は季節の表示名です。
+var seasonLabels = map[Season]LocalizedText{
+	SeasonSpring: {LangJA: "春", LangEN: "Spring", LangZH: "春季", LangKO: "봄", LangVI: "Mùa xuân"},
+	SeasonRainy:  {LangJA: "梅雨", LangEN: "Rainy season", LangZH: "梅雨季", LangKO: "장마", LangVI: "Mùa mưa"},
+	SeasonSummer: {LangJA: "夏", LangEN: "Summer", LangZH: "夏季", LangKO: "여름", LangVI: "Mùa hè"},
+	SeasonAutumn: {LangJA: "秋", LangEN: "Autumn", LangZH: "秋季", LangKO: "가을", LangVI: "Mùa thu"},
+	SeasonWinter: {LangJA: "冬", LangEN: "Winter", LangZH: "冬季", LangKO: "겨울", LangVI: "Mùa đông"},
+}
+
+// Label は lang での季節の表示名を返します。
+func (s Season) Label(lang Language) string {
+	return seasonLabels[s].In(lang)
+}
+
+// MonthDay は年によらない日付（月日）です。
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+// Period は毎年くり返す期間 [Start, End] です（両端を含む）。
+type Period struct {
+	Start, End MonthDay
+}
+
+// Contains は date の月日が期間に含まれるかどうかを返します。
+func (p Period) Contains(date time.Time) bool {
+	d := MonthDay{Month: date.Month(), Day: date.Day()}
+	return !d.before(p.Start) && !p.End.before(d)
+}
+
+func (d MonthDay) before(other MonthDay) bool {
+	return d.Month < other.Month || d.Month == other.Month && d.Day < other.Day
+}
+
+// SeasonOf は地域と日付から季節を求めます。
+// 地域の梅雨の時期（平年の梅雨入りから梅雨明けまで）は、月にかかわらず SeasonRainy になります。
+func SeasonOf(region Region, date time.Time) Season {
+	if rainy := ClimateProfileFor(region).RainySeason; rainy != nil && rainy.Contains(date) {
+		return SeasonRainy
+	}
+	switch date.Month() {
+	case time.March, time.April, time.May:
+		return SeasonSpring
+	case time.June, time.July, time.August:
+		return SeasonSummer
+	case time.September, time.October, time.November:
+		return SeasonAutumn
+	}
+	return SeasonWinter
+}
+
+// TemperatureBand は気温の体感のおおまかな区分です。
+// どの気温からどの区分になるかは、ルールファイルの temperature_bands で定義します。
+type TemperatureBand string
+
+const (
+	TemperatureBandCold   TemperatureBand = "cold"
+	TemperatureBandChilly TemperatureBand = "chilly"
+	TemperatureBandCool   TemperatureBand = "cool"
+	TemperatureBandMild   TemperatureBand = "mild"
+	TemperatureBandHot    TemperatureBand = "hot"
+)
+
+// TemperatureBandBound は気温の区分と、その区分になる日平均気温の上限（℃、未満）です。
+// 最後の区分は上限を持ちません（Max が nil）。
+type TemperatureBandBound struct {
+	Band TemperatureBand `yaml:"band"`
+	Max  *float64        `yaml:"max"`
+}
+
+// temperatureBandLabels は気温の区分の表示名です。
+var temperatureBandLabels = map[TemperatureBand]LocalizedText{
+	TemperatureBandCold:   {LangJA: "寒い", LangEN: "Cold", LangZH: "寒冷", LangKO: "추움", LangVI: "Lạnh"},
+	TemperatureBandChilly: {LangJA: "肌寒い", LangEN: "Chilly", LangZH: "微寒", LangKO: "쌀쌀함", LangVI: "Se lạnh"},
+	TemperatureBandCool:   {LangJA: "涼しい", LangEN: "Cool", LangZH: "凉爽", LangKO: "선선함", LangVI: "Mát mẻ"},
+	TemperatureBandMild:   {LangJA: "過ごしやすい", LangEN: "Mild", LangZH: "舒适", LangKO: "쾌적함", LangVI: "Dễ chịu"},
+	TemperatureBandHot:    {LangJA: "暑い", LangEN: "Hot", LangZH: "炎热", LangKO: "더움", LangVI: "Nóng"},
+}
+
+// TemperatureBand は日平均気温（℃）の区分を返します。
+// ルールに temperature_bands がない場合は、デフォルトのルールの区分を使います。
+func (rs *RuleSet) TemperatureBand(temperature float64) TemperatureBand {
+	bands := rs.TemperatureBands
+	if len(bands) == 0 {
+		bands = DefaultRuleSet().TemperatureBands
+	}
+	for _, b := range bands {
+		if b.Max == nil || temperature < *b.Max {
+			return b.Band
+		}
+	}
+	return bands[len(bands)-1].Band
+}
+
+// Label は lang での気温の区分の表示名を返します。
+func (b TemperatureBand) Label(lang Language) string {
+	return temperatureBandLabels[b].In(lang)
+}
+
+// validateTemperatureBands は気温の区分を検証します（省略した場合は検証しません）。
+//   - 区分が既知のもので、重複しないこと
+//   - 上限が低い順に並び、最後の区分だけが上限を持たないこと
+//   - 上限がいずれかのルールの気温の境界と同じ値であること（区分とルールの切り替わりを揃えるため）
+func (rs *RuleSet) validateTemperatureBands() []error {
+	if len(rs.TemperatureBands) == 0 {
+		return nil
+	}
+
+	thresholds := map[float64]bool{}
+	for _, g := range rs.Groups {
+		for _, r := range g.Rules {
+			for _, v := range []*float64{r.Temperature.Min, r.Temperature.Max} {
+				if v != nil {
+					thresholds[*v] = true
+				}
+			}
+		}
+	}
+
+	var errs []error
+	seen := map[TemperatureBand]bool{}
+	last := len(rs.TemperatureBands) - 1
+	for i, b := range rs.TemperatureBands {
+		if _, ok := temperatureBandLabels[b.Band]; !ok {
+			errs = append(errs, fmt.Errorf("temperature_bands: unknown band %q", b.Band))
+		} else if seen[b.Band] {
+			errs = append(errs, fmt.Errorf("temperature_bands: duplicate band %q", b.Band))
+		}
+		seen[b.Band] = true
+
+		if b.Max == nil {
+			if i < last {
+				errs = append(errs, fmt.Errorf("temperature_bands: band %q must have max", b.Band))
+			}
+			continue
+		}
+		switch {
+		case i == last:
+			errs = append(errs, fmt.Errorf("temperature_bands: the last band %q must not have max", b.Band))
+		case i > 0 && rs.TemperatureBands[i-1].Max != nil && *b.Max <= *rs.TemperatureBands[i-1].Max:
+			errs = append(errs, fmt.Errorf("temperature_bands: max of %q must be greater than the previous band", b.Band))
+		case !thresholds[*b.Max]:
+			errs = append(errs, fmt.Errorf("temperature_bands: max %v of %q is not a temperature boundary of any rule", *b.Max, b.Band))
+		}
+	}
+	return errs
+}
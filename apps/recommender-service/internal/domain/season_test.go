@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestSeasonOf(t *testing.T) {
+	tests := []struct {
+		region domain.Region
+		date   string
+		want   domain.Season
+	}{
+		{region: domain.RegionKanto, date: "2026-02-01", want: domain.SeasonWinter},
+		{region: domain.RegionKanto, date: "2026-03-01", want: domain.SeasonSpring},
+		{region: domain.RegionKanto, date: "2026-06-06", want: domain.SeasonSummer},
+		{region: domain.RegionKanto, date: "2026-06-07", want: domain.SeasonRainy},
+		{region: domain.RegionKanto, date: "2026-07-19", want: domain.SeasonRainy},
+		{region: domain.RegionKanto, date: "2026-07-20", want: domain.SeasonSummer},
+		{region: domain.RegionKanto, date: "2026-10-01", want: domain.SeasonAutumn},
+		{region: domain.RegionKanto, date: "2026-12-01", want: domain.SeasonWinter},
+		// 沖縄は5月から梅雨
+		{region: domain.RegionOkinawa, date: "2026-05-20", want: domain.SeasonRainy},
+		{region: domain.RegionOkinawa, date: "2026-07-01", want: domain.SeasonSummer},
+		// 北海道には梅雨がない
+		{region: domain.RegionHokkaido, date: "2026-07-01", want: domain.SeasonSummer},
+	}
+
+	for _, tt := range tests {
+		date, _ := time.Parse(time.DateOnly, tt.date)
+		if got := domain.SeasonOf(tt.region, date); got != tt.want {
+			t.Errorf("SeasonOf(%s, %s) = %q, want %q", tt.region, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestRuleSet_TemperatureBand_Default(t *testing.T) {
+	tests := []struct {
+		temperature float64
+		want        domain.TemperatureBand
+	}{
+		{temperature: -5, want: domain.TemperatureBandCold},
+		{temperature: 9.9, want: domain.TemperatureBandCold},
+		{temperature: 10, want: domain.TemperatureBandChilly},
+		{temperature: 15, want: domain.TemperatureBandCool},
+		{temperature: 20, want: domain.TemperatureBandMild},
+		{temperature: 25, want: domain.TemperatureBandHot},
+		{temperature: 32, want: domain.TemperatureBandHot},
+	}
+
+	for _, tt := range tests {
+		if got := domain.DefaultRuleSet().TemperatureBand(tt.temperature); got != tt.want {
+			t.Errorf("TemperatureBand(%v) = %q, want %q", tt.temperature, got, tt.want)
+		}
+	}
+}
+
+// TestRuleSet_TemperatureBand は気温の区分がルールファイルの temperature_bands で決まることを確認します。
+func TestRuleSet_TemperatureBand(t *testing.T) {
+	rs, err := domain.ParseRuleSet([]byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: cold, temperature: { max: 5 }, items: [短肌着] }
+      - { id: warm, temperature: { min: 5 }, items: [] }
+temperature_bands:
+  - { band: cold, max: 5 }
+  - { band: mild }
+`))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+	if got := rs.TemperatureBand(4.9); got != domain.TemperatureBandCold {
+		t.Errorf("TemperatureBand(4.9) = %q, want cold", got)
+	}
+	if got := rs.TemperatureBand(12); got != domain.TemperatureBandMild {
+		t.Errorf("TemperatureBand(12) = %q, want mild", got)
+	}
+
+	// temperature_bands がなければデフォルトのルールの区分を使う
+	rs, err = domain.ParseRuleSet([]byte(`
+version: 1
+groups:
+  - id: inner
+    rules:
+      - { id: all, items: [短肌着] }
+`))
+	if err != nil {
+		t.Fatalf("ParseRuleSet: %v", err)
+	}
+	if got := rs.TemperatureBand(12); got != domain.TemperatureBandChilly {
+		t.Errorf("TemperatureBand(12) = %q, want chilly", got)
+	}
+}
+
+func TestSeasonAndBand_Label(t *testing.T) {
+	if got := domain.SeasonRainy.Label(domain.LangJA); got != "梅雨" {
+		t.Errorf("SeasonRainy.Label(ja) = %q, want 梅雨", got)
+	}
+	if got := domain.SeasonWinter.Label(domain.LangEN); got != "Winter" {
+		t.Errorf("SeasonWinter.Label(en) = %q, want Winter", got)
+	}
+	if got := domain.TemperatureBandChilly.Label(domain.LangJA); got != "肌寒い" {
+		t.Errorf("TemperatureBandChilly.Label(ja) = %q, want 肌寒い", got)
+	}
+	if got := domain.TemperatureBandHot.Label(domain.LangKO); got != "더움" {
+		t.Errorf("TemperatureBandHot.Label(ko) = %q, want 더움", got)
+	}
+}
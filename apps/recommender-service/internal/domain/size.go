@@ -1,22 +1,167 @@
 package domain
 
+// Size は服のサイズです。日本のベビー服のサイズ表記（cm）の範囲で表します。
+// サイズ表記は適応身長の中心なので、サイズ80は身長75〜85cm程度に合います。
+type Size struct {
+	MinCM  int    // 範囲の最小のサイズ表記（cm）
+	MaxCM  int    // 範囲の最大のサイズ表記（cm）
+	Label  string // 正規化したラベル（例: "50-60"）
+	Legacy string // 従来の文字列表記（例: "50-60cm"）
+}
+
+// sizeFitMarginCM はサイズ表記から適応身長を求めるときの幅（±cm）です。
+const sizeFitMarginCM = 5
+
+var (
+	Size50To60 = Size{MinCM: 50, MaxCM: 60, Label: "50-60", Legacy: "50-60cm"}
+	Size60To70 = Size{MinCM: 60, MaxCM: 70, Label: "60-70", Legacy: "60-70cm"}
+	Size70To80 = Size{MinCM: 70, MaxCM: 80, Label: "70-80", Legacy: "70-80cm"}
+	Size80     = Size{MinCM: 80, MaxCM: 80, Label: "80", Legacy: "80cm"}
+	Size90     = Size{MinCM: 90, MaxCM: 90, Label: "90", Legacy: "90cm"}
+	Size90Plus = Size{MinCM: 90, MaxCM: 95, Label: "90-95", Legacy: "90cm+"}
+	// 従来の文字列表記では 90cm より大きいサイズをすべて "90cm+" で表していたため、
+	// 100cm 以上のサイズも Legacy は "90cm+" のままにし、Label で区別します。
+	Size100 = Size{MinCM: 100, MaxCM: 100, Label: "100", Legacy: "90cm+"}
+	Size110 = Size{MinCM: 110, MaxCM: 110, Label: "110", Legacy: "90cm+"}
+	Size120 = Size{MinCM: 120, MaxCM: 120, Label: "120", Legacy: "90cm+"}
+)
+
+// String は従来の文字列表記を返します。
+func (s Size) String() string {
+	return s.Legacy
+}
+
+// FitHeightRange は適応身長の範囲 [min, max) を返します。
+func (s Size) FitHeightRange() (minCM, maxCM float64) {
+	return float64(s.MinCM - sizeFitMarginCM), float64(s.MaxCM + sizeFitMarginCM)
+}
+
+// Includes はサイズ表記（cm）のいずれかがこのサイズの範囲に含まれるかどうかを返します。
+func (s Size) Includes(sizesCM []int) bool {
+	for _, cm := range sizesCM {
+		if s.MinCM <= cm && cm <= s.MaxCM {
+			return true
+		}
+	}
+	return false
+}
+
+// foreignSize は海外のサイズ表記と、その適応身長の範囲 [MinCM, MaxCM) です。
+type foreignSize struct {
+	Label        string
+	MinCM, MaxCM float64
+}
+
+// usSizes は米国のベビー服のサイズ（月齢/トドラー表記）の適応身長の目安です。
+var usSizes = []foreignSize{
+	{Label: "NB", MinCM: 0, MaxCM: 55},
+	{Label: "0-3M", MinCM: 55, MaxCM: 61},
+	{Label: "3-6M", MinCM: 61, MaxCM: 67},
+	{Label: "6-9M", MinCM: 67, MaxCM: 72},
+	{Label: "9-12M", MinCM: 72, MaxCM: 77},
+	{Label: "12-18M", MinCM: 77, MaxCM: 82},
+	{Label: "18-24M", MinCM: 82, MaxCM: 87},
+	{Label: "2T", MinCM: 87, MaxCM: 93},
+	{Label: "3T", MinCM: 93, MaxCM: 99},
+	{Label: "4T", MinCM: 99, MaxCM: 105},
+	{Label: "5T", MinCM: 105, MaxCM: 111},
+	{Label: "6", MinCM: 111, MaxCM: 117},
+	{Label: "7", MinCM: 117, MaxCM: 123},
+}
+
+// euSizes は欧州のベビー服のサイズ（身長表記）の適応身長の目安です。
+var euSizes = []foreignSize{
+	{Label: "50", MinCM: 44, MaxCM: 50},
+	{Label: "56", MinCM: 50, MaxCM: 56},
+	{Label: "62", MinCM: 56, MaxCM: 62},
+	{Label: "68", MinCM: 62, MaxCM: 68},
+	{Label: "74", MinCM: 68, MaxCM: 74},
+	{Label: "80", MinCM: 74, MaxCM: 80},
+	{Label: "86", MinCM: 80, MaxCM: 86},
+	{Label: "92", MinCM: 86, MaxCM: 92},
+	{Label: "98", MinCM: 92, MaxCM: 98},
+	{Label: "104", MinCM: 98, MaxCM: 104},
+	{Label: "110", MinCM: 104, MaxCM: 110},
+	{Label: "116", MinCM: 110, MaxCM: 116},
+	{Label: "122", MinCM: 116, MaxCM: 122},
+}
+
+// USSizes は適応身長が重なる米国サイズの表記を小さい順に返します。
+func (s Size) USSizes() []string {
+	return s.overlapping(usSizes)
+}
+
+// EUSizes は適応身長が重なる欧州サイズの表記を小さい順に返します。
+func (s Size) EUSizes() []string {
+	return s.overlapping(euSizes)
+}
+
+func (s Size) overlapping(table []foreignSize) []string {
+	lo, hi := s.FitHeightRange()
+	labels := []string{}
+	for _, f := range table {
+		if f.MinCM < hi && lo < f.MaxCM {
+			labels = append(labels, f.Label)
+		}
+	}
+	return labels
+}
+
 // EstimateSize は月齢に基づいて大まかな服のサイズを推測します。
 // （※あくまで一般的な目安であり、赤ちゃんにより個人差があります）
-func EstimateSize(ageInMonths int) string {
+func EstimateSize(ageInMonths int) Size {
 	if ageInMonths < 3 {
-		return "50-60cm"
+		return Size50To60
 	}
 	if ageInMonths < 6 {
-		return "60-70cm"
+		return Size60To70
 	}
 	if ageInMonths < 12 {
-		return "70-80cm"
+		return Size70To80
 	}
 	if ageInMonths < 18 {
-		return "80cm"
+		return Size80
 	}
 	if ageInMonths < 24 {
-		return "90cm"
+		return Size90
+	}
+	if ageInMonths < 36 {
+		return Size90Plus
+	}
+	if ageInMonths < 48 {
+		return Size100
+	}
+	if ageInMonths < 66 {
+		return Size110
+	}
+	return Size120
+}
+
+// EstimateSizeFromHeight は身長（cm）に基づいて服のサイズを推測します。
+func EstimateSizeFromHeight(heightCM float64) Size {
+	if heightCM < 60 {
+		return Size50To60
+	}
+	if heightCM < 70 {
+		return Size60To70
+	}
+	if heightCM < 75 {
+		return Size70To80
+	}
+	if heightCM < 85 {
+		return Size80
+	}
+	if heightCM < 95 {
+		return Size90
+	}
+	if heightCM < 100 {
+		return Size90Plus
+	}
+	if heightCM < 105 {
+		return Size100
+	}
+	if heightCM < 115 {
+		return Size110
 	}
-	return "90cm+"
+	return Size120
 }
@@ -0,0 +1,114 @@
+package domain_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestEstimateSize(t *testing.T) {
+	tests := []struct {
+		ageInMonths int
+		want        string
+		wantLabel   string // 2歳以降のサイズの正規化したラベル（従来の表記はすべて "90cm+"）
+	}{
+		{ageInMonths: 0, want: "50-60cm"},
+		{ageInMonths: 3, want: "60-70cm"},
+		{ageInMonths: 6, want: "70-80cm"},
+		{ageInMonths: 12, want: "80cm"},
+		{ageInMonths: 18, want: "90cm"},
+		{ageInMonths: 24, want: "90cm+", wantLabel: "90-95"},
+		{ageInMonths: 36, want: "90cm+", wantLabel: "100"},
+		{ageInMonths: 48, want: "90cm+", wantLabel: "110"},
+		{ageInMonths: 66, want: "90cm+", wantLabel: "120"},
+		{ageInMonths: 72, want: "90cm+", wantLabel: "120"},
+	}
+
+	for _, tt := range tests {
+		s := domain.EstimateSize(tt.ageInMonths)
+		if got := s.String(); got != tt.want {
+			t.Errorf("EstimateSize(%d) = %q, want %q", tt.ageInMonths, got, tt.want)
+		}
+		if tt.wantLabel != "" && s.Label != tt.wantLabel {
+			t.Errorf("EstimateSize(%d).Label = %q, want %q", tt.ageInMonths, s.Label, tt.wantLabel)
+		}
+	}
+}
+
+func TestEstimateSizeFromHeight(t *testing.T) {
+	tests := []struct {
+		heightCM  float64
+		want      string
+		wantLabel string // 95cm 以上のサイズの正規化したラベル（従来の表記はすべて "90cm+"）
+	}{
+		{heightCM: 49, want: "50-60cm"},
+		{heightCM: 62, want: "60-70cm"},
+		{heightCM: 72, want: "70-80cm"},
+		{heightCM: 78, want: "80cm"},
+		{heightCM: 88, want: "90cm"},
+		{heightCM: 97, want: "90cm+", wantLabel: "90-95"},
+		{heightCM: 100, want: "90cm+", wantLabel: "100"},
+		{heightCM: 108, want: "90cm+", wantLabel: "110"},
+		{heightCM: 118, want: "90cm+", wantLabel: "120"},
+	}
+
+	for _, tt := range tests {
+		s := domain.EstimateSizeFromHeight(tt.heightCM)
+		if got := s.String(); got != tt.want {
+			t.Errorf("EstimateSizeFromHeight(%v) = %q, want %q", tt.heightCM, got, tt.want)
+		}
+		if tt.wantLabel != "" && s.Label != tt.wantLabel {
+			t.Errorf("EstimateSizeFromHeight(%v).Label = %q, want %q", tt.heightCM, s.Label, tt.wantLabel)
+		}
+	}
+}
+
+func TestSize_Conversions(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   domain.Size
+		wantUS []string
+		wantEU []string
+	}{
+		{
+			name:   "50-60",
+			size:   domain.Size50To60,
+			wantUS: []string{"NB", "0-3M", "3-6M"},
+			wantEU: []string{"50", "56", "62", "68"},
+		},
+		{
+			name:   "80",
+			size:   domain.Size80,
+			wantUS: []string{"9-12M", "12-18M", "18-24M"},
+			wantEU: []string{"80", "86"},
+		},
+		{
+			name:   "110",
+			size:   domain.Size110,
+			wantUS: []string{"5T", "6"},
+			wantEU: []string{"110", "116"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.size.USSizes(); !slices.Equal(got, tt.wantUS) {
+				t.Errorf("USSizes() = %v, want %v", got, tt.wantUS)
+			}
+			if got := tt.size.EUSizes(); !slices.Equal(got, tt.wantEU) {
+				t.Errorf("EUSizes() = %v, want %v", got, tt.wantEU)
+			}
+		})
+	}
+}
+
+// TestEstimateSize_LegacyLabelsAreStable は構造化したサイズでも従来の文字列表記が変わらないことを確認します。
+func TestEstimateSize_LegacyLabelsAreStable(t *testing.T) {
+	for age := 0; age <= domain.MaxMilestoneMonth; age++ {
+		s := domain.EstimateSize(age)
+		if s.Legacy == "" || s.Label == "" || s.MinCM > s.MaxCM {
+			t.Errorf("EstimateSize(%d) = %+v is malformed", age, s)
+		}
+	}
+}
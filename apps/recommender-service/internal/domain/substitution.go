@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Substitution は「From のアイテムをまとめて To のアイテムに置き換えられる」という対応です。
+// 置き換え表はルールファイルの substitutions に定義します。
+type Substitution struct {
+	From []string `yaml:"from"`
+	To   []string `yaml:"to"`
+	Age  Band     `yaml:"age_months"` // 置き換えられる月齢の範囲（省略すると制限なし）
+}
+
+// maxAlternatives は1つのコーディネートに付ける代わりのコーディネートの最大数です。
+const maxAlternatives = 3
+
+// AlternativeOutfit は置き換え表を使って作った代わりのコーディネートです。
+type AlternativeOutfit struct {
+	Items         []OutfitItem   // レイヤーの順
+	Warmth        float64        // アイテムの暖かさの合計（clo）
+	Substitutions []Substitution // 元のコーディネートに適用した置き換え
+}
+
+// alternatives は置き換え表を1つ、または重ならない2つを適用した代わりのコーディネートを返します。
+// 置き換え先のアイテムは、そのシーン・月齢でルールが推薦することがあり、サイズ size をどこかのショップで扱っているものに限ります。
+// 元のコーディネートが目標の暖かさに届いていれば目標に、届いていなければ元の暖かさに届くものだけを残し、
+// 置き換えの少ない順、着せすぎにならない（届かせたい暖かさとの差が小さい）順、置き換え表の順に最大 maxAlternatives 件を返します。
+func (rs *RuleSet) alternatives(c *Catalog, o LayeredOutfit, ageInMonths int, size Size) []AlternativeOutfit {
+	var usable []Substitution
+	for _, s := range rs.Substitutions {
+		if s.Age.Contains(float64(ageInMonths)) && o.canSubstitute(s) && rs.wearable(c, s.To, o.Context, ageInMonths, size) {
+			usable = append(usable, s)
+		}
+	}
+
+	var combos [][]Substitution
+	for i, s := range usable {
+		combos = append(combos, []Substitution{s})
+		for _, t := range usable[i+1:] {
+			if !overlaps(s, t) {
+				combos = append(combos, []Substitution{s, t})
+			}
+		}
+	}
+
+	required := min(o.Warmth, o.TargetWarmth)
+	alts := []AlternativeOutfit{}
+	for _, combo := range combos {
+		alt := substitute(c, o, combo)
+		if roundWarmth(alt.Warmth) >= roundWarmth(required) {
+			alts = append(alts, alt)
+		}
+	}
+	slices.SortStableFunc(alts, func(a, b AlternativeOutfit) int {
+		return cmp.Or(
+			cmp.Compare(len(a.Substitutions), len(b.Substitutions)),
+			cmp.Compare(roundWarmth(a.Warmth-required), roundWarmth(b.Warmth-required)),
+		)
+	})
+	if len(alts) > maxAlternatives {
+		alts = alts[:maxAlternatives]
+	}
+	return alts
+}
+
+// wearable は items がどれも、シーン ctx・月齢 ageInMonths でルールが推薦することがあり、
+// サイズ size をいずれかのショップで扱っているかどうかを返します。
+func (rs *RuleSet) wearable(c *Catalog, items []string, ctx Context, ageInMonths int, size Size) bool {
+	for _, item := range items {
+		if !rs.recommendsAt(item, ctx, ageInMonths) {
+			return false
+		}
+		if !slices.ContainsFunc(c.Shops, func(shop Shop) bool { return c.Available(item, shop.Key, size) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// canSubstitute はコーディネートに s の From がすべて含まれ、To がどれも含まれていないかどうかを返します。
+func (o LayeredOutfit) canSubstitute(s Substitution) bool {
+	return !slices.ContainsFunc(s.From, func(item string) bool { return !o.contains(item) }) &&
+		!slices.ContainsFunc(s.To, o.contains)
+}
+
+// overlaps は2つの置き換えが同じアイテムを扱うかどうかを返します。
+func overlaps(s, t Substitution) bool {
+	items := slices.Concat(s.From, s.To)
+	return slices.ContainsFunc(slices.Concat(t.From, t.To), func(item string) bool { return slices.Contains(items, item) })
+}
+
+// substitute はコーディネートに置き換えを適用します。
+// 置き換えたアイテムの推薦理由には、置き換えた元のアイテムのうち最初のものの理由を使います。
+func substitute(c *Catalog, o LayeredOutfit, subs []Substitution) AlternativeOutfit {
+	items := slices.Clone(o.Items)
+	for _, s := range subs {
+		idx := slices.IndexFunc(items, func(i OutfitItem) bool { return i.Item == s.From[0] })
+		reason := items[idx].Reason
+		items = slices.DeleteFunc(items, func(i OutfitItem) bool { return slices.Contains(s.From, i.Item) })
+		for _, name := range s.To {
+			items = append(items, newOutfitItem(c, Recommendation{Item: name, Reason: reason}))
+		}
+	}
+	for i := range items {
+		items[i].Replaces = ""
+		items[i].Equivalents = []string{}
+	}
+	slices.SortStableFunc(items, func(a, b OutfitItem) int {
+		return a.Layer.order() - b.Layer.order()
+	})
+	return AlternativeOutfit{Items: items, Warmth: totalWarmth(items), Substitutions: subs}
+}
+
+// validateSubstitutions は置き換え表を検証します。
+//   - from と to が空でなく、同じアイテムを両方に含まないこと
+//   - 月齢の範囲が min < max であること
+//   - to のアイテムが、その月齢の範囲のいずれかでルールに推薦されること（使われることのない置き換えを防ぐ）
+func (rs *RuleSet) validateSubstitutions() []error {
+	var errs []error
+	for i, s := range rs.Substitutions {
+		if len(s.From) == 0 || len(s.To) == 0 {
+			errs = append(errs, fmt.Errorf("substitution %d: from and to must not be empty", i))
+		}
+		if slices.ContainsFunc(s.From, func(item string) bool { return slices.Contains(s.To, item) }) {
+			errs = append(errs, fmt.Errorf("substitution %d: from and to share an item", i))
+		}
+		if !validBand(s.Age) {
+			errs = append(errs, fmt.Errorf("substitution %d: age_months min must be less than max", i))
+			continue
+		}
+		for _, item := range s.To {
+			if !rs.recommendsDuring(item, s.Age) {
+				errs = append(errs, fmt.Errorf("substitution %d: item %q is not recommended by any rule within age_months", i, item))
+			}
+		}
+	}
+	return errs
+}
+
+// recommendsDuring は月齢の範囲 age のどこかで item を推薦するルールがあるかどうかを返します。
+func (rs *RuleSet) recommendsDuring(item string, age Band) bool {
+	for _, g := range rs.Groups {
+		for _, r := range g.Rules {
+			if r.Age.overlaps(age) && slices.Contains(r.Items, item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlaps は2つの範囲が重なるかどうかを返します。
+func (b Band) overlaps(o Band) bool {
+	return (b.Min == nil || o.Max == nil || *b.Min < *o.Max) && (o.Min == nil || b.Max == nil || *o.Min < *b.Max)
+}
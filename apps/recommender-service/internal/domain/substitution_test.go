@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestBuildOutfit_Alternatives(t *testing.T) {
+	tests := []struct {
+		name        string
+		ageInMonths int
+		temperature float64
+		wantFirst   []string // 最もよい代わりのコーディネートのアイテム（nil は代わりがないこと）
+	}{
+		{
+			name:        "カバーオールの代わりにロンパース+レッグウォーマー",
+			ageInMonths: 6,
+			temperature: 12,
+			wantFirst:   []string{"ボディースーツ", "ロンパース", "ベスト", "靴下", "レッグウォーマー"},
+		},
+		{
+			name:        "ロンパースの代わりにツーウェイオール",
+			ageInMonths: 1,
+			temperature: 17,
+			wantFirst:   []string{"短肌着", "コンビ肌着", "ツーウェイオール"},
+		},
+		{
+			name:        "ルールがツーウェイオールを推薦しない月齢では代わりにしない",
+			ageInMonths: 3,
+			temperature: 17,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := defaultOutfit(domain.ContextOuting, tt.ageInMonths, domain.TemperatureEstimate{Mean: tt.temperature, Min: tt.temperature, Max: tt.temperature})
+			if tt.wantFirst == nil {
+				if len(o.Alternatives) != 0 {
+					t.Errorf("alternatives = %+v, want none", o.Alternatives)
+				}
+				return
+			}
+			if len(o.Alternatives) == 0 || len(o.Alternatives) > 3 {
+				t.Fatalf("len(alternatives) = %d, want 1-3", len(o.Alternatives))
+			}
+
+			var alts [][]string
+			for _, alt := range o.Alternatives {
+				var names []string
+				for _, item := range alt.Items {
+					names = append(names, item.Item)
+				}
+				alts = append(alts, names)
+				if alt.Warmth < min(o.Warmth, o.TargetWarmth) {
+					t.Errorf("alternative %v: warmth %v is below the required %v", names, alt.Warmth, min(o.Warmth, o.TargetWarmth))
+				}
+			}
+			if !slices.Equal(alts[0], tt.wantFirst) {
+				t.Errorf("alternatives[0] = %v, want %v (all: %v)", alts[0], tt.wantFirst, alts)
+			}
+		})
+	}
+}
+
+// TestBuildOutfit_Alternatives_Size は、置き換え先のアイテムがそのサイズを扱っていなければ代わりにしないことを確認します。
+func TestBuildOutfit_Alternatives_Size(t *testing.T) {
+	rules, catalog := domain.DefaultRuleSet(), domain.DefaultCatalog()
+	temp := domain.TemperatureEstimate{Mean: 17, Min: 17, Max: 17}
+
+	// ツーウェイオールはどのショップも 70cm まで
+	if o := rules.BuildOutfit(catalog, domain.ContextOuting, 1, domain.Size50To60, temp); len(o.Alternatives) == 0 {
+		t.Error("size 50-60: alternatives should include ツーウェイオール")
+	}
+	if o := rules.BuildOutfit(catalog, domain.ContextOuting, 1, domain.Size80, temp); len(o.Alternatives) != 0 {
+		t.Errorf("size 80: alternatives = %+v, want none", o.Alternatives)
+	}
+}
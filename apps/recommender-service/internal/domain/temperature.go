@@ -1,29 +1,199 @@
 package domain
 
-import "time"
-
-// 月ごとの平均気温（東京周辺の目安、単位: ℃）
-// TODO: 将来的にエリア設定等を入れればより正確になりますが、今のところ共通の目安とします。
-var monthlyAverageTemp = map[time.Month]float64{
-	time.January:   5.0,
-	time.February:  6.0,
-	time.March:     9.0,
-	time.April:     14.0,
-	time.May:       18.0,
-	time.June:      21.0,
-	time.July:      25.0,
-	time.August:    26.0,
-	time.September: 23.0,
-	time.October:   18.0,
-	time.November:  12.0,
-	time.December:  8.0,
-}
-
-// EstimateTemperature は日付に対応するおおよその気温を推測します。
-func EstimateTemperature(date time.Time) float64 {
-	month := date.Month()
-	if temp, ok := monthlyAverageTemp[month]; ok {
-		return temp
-	}
-	return 15.0 // フォールバック
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ClimateProfile は地域ごとの月別平年値（単位: ℃）です。
+type ClimateProfile struct {
+	Region              Region
+	Label               string                 // 地域の表示名
+	Station             string                 // 代表となる観測地点
+	MonthlyAverageTemp  map[time.Month]float64 // 日平均気温の月平均
+	MonthlyDiurnalRange map[time.Month]float64 // 日較差（最高気温 - 最低気温）の月平均
+	RainySeason         *Period                // 梅雨の平年の時期（梅雨入りから梅雨明けまで。梅雨のない地域は nil）
+}
+
+// TemperatureEstimate はある1日の推定気温です。
+type TemperatureEstimate struct {
+	Mean float64 // 日平均気温
+	Min  float64 // 最低気温（朝晩の目安）
+	Max  float64 // 最高気温（日中の目安）
+}
+
+// climateProfiles は地域ごとの気候プロファイルです。
+// 関東甲信以外の値は気象庁の平年値（1991-2020）を丸めた目安です。梅雨の時期は、東北は東北南部、九州は九州北部の平年値です。
+var climateProfiles = map[Region]ClimateProfile{
+	RegionHokkaido: {
+		Region: RegionHokkaido, Label: "北海道", Station: "札幌",
+		MonthlyAverageTemp:  monthly(-3.2, -2.7, 1.1, 7.3, 13.0, 17.0, 21.1, 22.3, 18.6, 12.1, 5.2, -0.9),
+		MonthlyDiurnalRange: monthly(6.0, 6.4, 6.8, 8.4, 9.2, 8.8, 8.1, 7.8, 8.3, 8.3, 6.7, 5.9),
+	},
+	RegionTohoku: {
+		Region: RegionTohoku, Label: "東北", Station: "仙台",
+		MonthlyAverageTemp:  monthly(2.0, 2.4, 5.5, 10.7, 15.6, 19.2, 22.9, 24.4, 21.2, 15.7, 9.8, 4.5),
+		MonthlyDiurnalRange: monthly(7.7, 8.2, 8.7, 9.3, 8.7, 7.2, 6.2, 6.7, 6.9, 8.3, 8.6, 8.0),
+		RainySeason:         &Period{Start: MonthDay{time.June, 12}, End: MonthDay{time.July, 24}},
+	},
+	RegionKanto: {
+		// 日平均気温は、地域を選べるようにする前から使っている東京周辺の目安値のままです（気象庁の平年値ではありません）。
+		// 既存の推薦結果を変えないために据え置いています。日較差と梅雨の時期は気象庁の平年値です。
+		Region: RegionKanto, Label: "関東甲信", Station: "東京",
+		MonthlyAverageTemp:  monthly(5.0, 6.0, 9.0, 14.0, 18.0, 21.0, 25.0, 26.0, 23.0, 18.0, 12.0, 8.0),
+		MonthlyDiurnalRange: monthly(8.6, 8.8, 9.2, 9.6, 9.0, 7.6, 7.5, 7.8, 7.2, 7.1, 8.1, 8.2),
+		RainySeason:         &Period{Start: MonthDay{time.June, 7}, End: MonthDay{time.July, 19}},
+	},
+	RegionHokuriku: {
+		Region: RegionHokuriku, Label: "北陸", Station: "新潟",
+		MonthlyAverageTemp:  monthly(2.9, 3.1, 6.1, 11.3, 16.5, 20.6, 24.8, 26.6, 22.6, 16.6, 10.6, 5.4),
+		MonthlyDiurnalRange: monthly(5.6, 6.3, 8.0, 9.9, 9.6, 8.6, 7.9, 8.5, 8.2, 8.5, 7.3, 5.9),
+		RainySeason:         &Period{Start: MonthDay{time.June, 11}, End: MonthDay{time.July, 23}},
+	},
+	RegionTokai: {
+		Region: RegionTokai, Label: "東海", Station: "名古屋",
+		MonthlyAverageTemp:  monthly(4.8, 5.5, 9.2, 14.6, 19.4, 23.0, 26.9, 28.2, 24.5, 18.6, 12.6, 7.2),
+		MonthlyDiurnalRange: monthly(9.5, 9.8, 10.2, 10.3, 9.7, 8.4, 8.0, 8.6, 8.3, 9.1, 9.6, 9.6),
+		RainySeason:         &Period{Start: MonthDay{time.June, 6}, End: MonthDay{time.July, 19}},
+	},
+	RegionKinki: {
+		Region: RegionKinki, Label: "近畿", Station: "大阪",
+		MonthlyAverageTemp:  monthly(6.2, 6.6, 9.9, 15.2, 20.0, 23.6, 27.7, 29.0, 25.2, 19.5, 13.8, 8.7),
+		MonthlyDiurnalRange: monthly(8.0, 8.2, 8.8, 9.4, 9.1, 7.8, 7.8, 8.2, 7.8, 8.2, 8.0, 7.9),
+		RainySeason:         &Period{Start: MonthDay{time.June, 6}, End: MonthDay{time.July, 19}},
+	},
+	RegionChugoku: {
+		Region: RegionChugoku, Label: "中国", Station: "広島",
+		MonthlyAverageTemp:  monthly(5.7, 6.4, 9.7, 15.1, 19.9, 23.3, 27.4, 28.9, 25.0, 19.1, 13.0, 7.7),
+		MonthlyDiurnalRange: monthly(8.7, 8.9, 9.3, 9.8, 9.4, 7.9, 7.5, 8.0, 8.3, 9.5, 9.4, 8.8),
+		RainySeason:         &Period{Start: MonthDay{time.June, 6}, End: MonthDay{time.July, 19}},
+	},
+	RegionShikoku: {
+		Region: RegionShikoku, Label: "四国", Station: "高松",
+		MonthlyAverageTemp:  monthly(5.9, 6.3, 9.4, 14.7, 19.8, 23.3, 27.5, 28.6, 24.7, 18.9, 13.1, 8.1),
+		MonthlyDiurnalRange: monthly(8.9, 9.2, 9.7, 10.3, 9.8, 8.5, 8.0, 8.4, 8.2, 9.1, 9.3, 8.9),
+		RainySeason:         &Period{Start: MonthDay{time.June, 5}, End: MonthDay{time.July, 17}},
+	},
+	RegionKyushu: {
+		Region: RegionKyushu, Label: "九州", Station: "福岡",
+		MonthlyAverageTemp:  monthly(6.9, 7.8, 10.8, 15.4, 19.9, 23.3, 27.4, 28.4, 24.7, 19.6, 14.2, 9.1),
+		MonthlyDiurnalRange: monthly(7.3, 7.7, 8.3, 8.8, 8.5, 7.3, 7.0, 7.5, 7.5, 8.3, 8.0, 7.4),
+		RainySeason:         &Period{Start: MonthDay{time.June, 4}, End: MonthDay{time.July, 19}},
+	},
+	RegionOkinawa: {
+		Region: RegionOkinawa, Label: "沖縄", Station: "那覇",
+		MonthlyAverageTemp:  monthly(17.3, 17.5, 19.1, 21.5, 24.2, 27.1, 29.0, 28.9, 27.9, 25.5, 22.5, 19.0),
+		MonthlyDiurnalRange: monthly(5.5, 5.7, 5.8, 5.8, 5.7, 5.5, 6.1, 6.0, 5.9, 5.7, 5.6, 5.5),
+		RainySeason:         &Period{Start: MonthDay{time.May, 10}, End: MonthDay{time.June, 21}},
+	},
+}
+
+// monthly は1月から12月の順に並べた値を月ごとのマップに変換します。
+func monthly(values ...float64) map[time.Month]float64 {
+	m := make(map[time.Month]float64, len(values))
+	for i, v := range values {
+		m[time.Month(i+1)] = v
+	}
+	return m
+}
+
+// ClimateProfileFor は地域に対応する気候プロファイルを返します。
+// 未知の地域の場合は DefaultRegion のプロファイルを返します。
+func ClimateProfileFor(region Region) ClimateProfile {
+	if p, ok := climateProfiles[region]; ok {
+		return p
+	}
+	return climateProfiles[DefaultRegion]
+}
+
+// anchorDay は月別平年値を代表させる日（月の中旬）です。
+const anchorDay = 15
+
+// EstimateTemperature は日付に対応するおおよその気温を推測します（DefaultRegion の目安）。
+func EstimateTemperature(date time.Time) TemperatureEstimate {
+	return EstimateRegionalTemperature(DefaultRegion, date)
+}
+
+// EstimateRegionalTemperature は地域と日付に対応するおおよその気温を推測します。
+// 各月の平年値を月の中旬に置き、前後の月との間をコサイン補間して日単位の値を求めます。
+func EstimateRegionalTemperature(region Region, date time.Time) TemperatureEstimate {
+	return ClimateProfileFor(region).Estimate(date)
+}
+
+// Estimate はこのプロファイルで日付に対応する気温を推測します。
+func (p ClimateProfile) Estimate(date time.Time) TemperatureEstimate {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	// date を挟む2つのアンカー（前月/当月 または 当月/翌月 の中旬）を求める
+	prev := time.Date(day.Year(), day.Month(), anchorDay, 0, 0, 0, 0, time.UTC)
+	if day.Before(prev) {
+		prev = prev.AddDate(0, -1, 0)
+	}
+	next := prev.AddDate(0, 1, 0)
+
+	frac := day.Sub(prev).Hours() / next.Sub(prev).Hours()
+	weight := (1 - math.Cos(math.Pi*frac)) / 2
+
+	mean := interpolate(p.MonthlyAverageTemp, prev.Month(), next.Month(), weight, 15.0)
+	diurnal := interpolate(p.MonthlyDiurnalRange, prev.Month(), next.Month(), weight, 0)
+
+	return TemperatureEstimate{
+		Mean: mean,
+		Min:  mean - diurnal/2,
+		Max:  mean + diurnal/2,
+	}
+}
+
+// interpolate は2つの月の値を weight（0〜1）で補間します。値がない月は fallback を使います。
+func interpolate(values map[time.Month]float64, from, to time.Month, weight, fallback float64) float64 {
+	a, ok := values[from]
+	if !ok {
+		a = fallback
+	}
+	b, ok := values[to]
+	if !ok {
+		b = fallback
+	}
+	return a + (b-a)*weight
+}
+
+// TemperatureUnit は利用者が指定する気温の単位です。
+type TemperatureUnit string
+
+const (
+	Celsius    TemperatureUnit = "celsius"    // ℃
+	Fahrenheit TemperatureUnit = "fahrenheit" // ℉
+)
+
+// 指定された気温として受け付ける範囲（℃）です。
+const (
+	minSpecifiedTemp = -50.0
+	maxSpecifiedTemp = 50.0
+)
+
+// ErrInvalidTemperature は指定された気温が現実的でない場合のエラーです。
+var ErrInvalidTemperature = errors.New("invalid temperature")
+
+// ParseTemperatureUnit は文字列を気温の単位に変換します。
+func ParseTemperatureUnit(s string) (TemperatureUnit, error) {
+	u := TemperatureUnit(s)
+	if u != Celsius && u != Fahrenheit {
+		return "", fmt.Errorf("unknown temperature unit: %q", s)
+	}
+	return u, nil
+}
+
+// ToCelsius は単位 u の気温 v を摂氏に変換し、受け付ける範囲にあるかを確認します。
+func (u TemperatureUnit) ToCelsius(v float64) (float64, error) {
+	c := v
+	if u == Fahrenheit {
+		c = (v - 32) * 5 / 9
+	}
+	if math.IsNaN(c) || c < minSpecifiedTemp || c > maxSpecifiedTemp {
+		return 0, fmt.Errorf("%w: temperature must be between %s℃ and %s℃",
+			ErrInvalidTemperature, formatNumber(minSpecifiedTemp), formatNumber(maxSpecifiedTemp))
+	}
+	return c, nil
 }
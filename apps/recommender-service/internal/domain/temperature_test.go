@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -13,19 +14,20 @@ func TestEstimateTemperature(t *testing.T) {
 		date     time.Time
 		wantTemp float64
 	}{
+		// 月の中旬は平年値そのもの
 		{
-			name:     "1月",
+			name:     "1月中旬",
 			date:     time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
 			wantTemp: 5.0,
 		},
 		{
-			name:     "8月",
-			date:     time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+			name:     "8月中旬",
+			date:     time.Date(2025, time.August, 15, 0, 0, 0, 0, time.UTC),
 			wantTemp: 26.0,
 		},
 		{
-			name:     "11月",
-			date:     time.Date(2025, time.November, 30, 0, 0, 0, 0, time.UTC),
+			name:     "11月中旬",
+			date:     time.Date(2025, time.November, 15, 0, 0, 0, 0, time.UTC),
 			wantTemp: 12.0,
 		},
 	}
@@ -33,9 +35,120 @@ func TestEstimateTemperature(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := domain.EstimateTemperature(tt.date)
-			if got != tt.wantTemp {
-				t.Errorf("EstimateTemperature() = %v, want %v", got, tt.wantTemp)
+			if math.Abs(got.Mean-tt.wantTemp) > 1e-9 {
+				t.Errorf("EstimateTemperature().Mean = %v, want %v", got.Mean, tt.wantTemp)
 			}
 		})
 	}
 }
+
+// TestEstimateTemperature_Interpolated は、月の中旬以外では前後の月の平年値の間の値になることを確認します。
+func TestEstimateTemperature_Interpolated(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		low      float64
+		high     float64
+		wantNear float64 // 中間点付近で期待する値（±0.5℃）
+	}{
+		{
+			name:     "3月1日は2月(6.0)と3月(9.0)の間",
+			date:     time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			low:      6.0,
+			high:     9.0,
+			wantNear: 7.5,
+		},
+		{
+			name:     "12月31日は12月(8.0)と1月(5.0)の間（年またぎ）",
+			date:     time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC),
+			low:      5.0,
+			high:     8.0,
+			wantNear: 6.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.EstimateTemperature(tt.date).Mean
+			if got <= tt.low || got >= tt.high {
+				t.Errorf("EstimateTemperature(%s).Mean = %v, want between %v and %v",
+					tt.date.Format("2006-01-02"), got, tt.low, tt.high)
+			}
+			if math.Abs(got-tt.wantNear) > 0.5 {
+				t.Errorf("EstimateTemperature(%s).Mean = %v, want near %v",
+					tt.date.Format("2006-01-02"), got, tt.wantNear)
+			}
+		})
+	}
+}
+
+// TestEstimateTemperature_DistinguishesDaysInMonth は、同じ月でも日によって気温が変わることを確認します。
+func TestEstimateTemperature_DistinguishesDaysInMonth(t *testing.T) {
+	march1 := domain.EstimateTemperature(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+	march31 := domain.EstimateTemperature(time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC))
+	if march31.Mean <= march1.Mean {
+		t.Errorf("3月31日 (%v) は 3月1日 (%v) より暖かいはず", march31.Mean, march1.Mean)
+	}
+}
+
+// TestEstimateTemperature_Range は最低/最高気温が日平均気温を挟むことを確認します。
+func TestEstimateTemperature_Range(t *testing.T) {
+	for d := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC); d.Year() == 2025; d = d.AddDate(0, 0, 1) {
+		got := domain.EstimateTemperature(d)
+		if !(got.Min < got.Mean && got.Mean < got.Max) {
+			t.Fatalf("EstimateTemperature(%s) = %+v, want Min < Mean < Max", d.Format("2006-01-02"), got)
+		}
+	}
+}
+
+func TestEstimateRegionalTemperature(t *testing.T) {
+	january := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	sapporo := domain.EstimateRegionalTemperature(domain.RegionHokkaido, january).Mean
+	tokyo := domain.EstimateRegionalTemperature(domain.RegionKanto, january).Mean
+	naha := domain.EstimateRegionalTemperature(domain.RegionOkinawa, january).Mean
+
+	if !(sapporo < tokyo && tokyo < naha) {
+		t.Errorf("1月の気温は 札幌 < 東京 < 那覇 のはず: got %v, %v, %v", sapporo, tokyo, naha)
+	}
+
+	// 未知の地域はデフォルト地域にフォールバックする
+	if got := domain.EstimateRegionalTemperature("unknown", january).Mean; got != tokyo {
+		t.Errorf("EstimateRegionalTemperature(unknown) = %v, want %v (default region)", got, tokyo)
+	}
+}
+
+func TestTemperatureUnit_ToCelsius(t *testing.T) {
+	tests := []struct {
+		unit    string
+		value   float64
+		want    float64
+		wantErr bool
+	}{
+		{unit: "celsius", value: 8, want: 8},
+		{unit: "fahrenheit", value: 46.4, want: 8},
+		{unit: "fahrenheit", value: -40, want: -40},
+		{unit: "celsius", value: 51, wantErr: true},
+		{unit: "fahrenheit", value: 200, wantErr: true},
+		{unit: "celsius", value: math.NaN(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		u, err := domain.ParseTemperatureUnit(tt.unit)
+		if err != nil {
+			t.Fatalf("ParseTemperatureUnit(%q): %v", tt.unit, err)
+		}
+		got, err := u.ToCelsius(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ToCelsius(%v %s) error = %v, wantErr %v", tt.value, tt.unit, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ToCelsius(%v %s) = %v, want %v", tt.value, tt.unit, got, tt.want)
+		}
+	}
+
+	if _, err := domain.ParseTemperatureUnit("kelvin"); err == nil {
+		t.Error("ParseTemperatureUnit(\"kelvin\") should fail")
+	}
+}
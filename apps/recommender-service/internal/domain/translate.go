@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// nameRef はショップ固有名の逆引きインデックスの1エントリです。
+type nameRef struct {
+	item int    // Catalog.Items の添字
+	shop string // ショップのキー
+}
+
+// Translation はショップ固有名から汎用名を逆引きした結果です。
+type Translation struct {
+	Item  CatalogItem
+	Shop  Shop   // 一致したショップ
+	Name  string // 一致したショップ固有名
+	Exact bool   // 表記が完全に一致したかどうか（false は表記ゆれを吸収して一致）
+}
+
+// buildNameIndex はショップ固有名（正規化済み）からアイテムを引く逆引きインデックスを作ります。
+func (c *Catalog) buildNameIndex() {
+	c.names = map[string][]nameRef{}
+	for i, item := range c.Items {
+		for _, shop := range c.Shops {
+			key := NormalizeName(item.ShopNames[shop.Key])
+			c.names[key] = append(c.names[key], nameRef{item: i, shop: shop.Key})
+		}
+	}
+}
+
+// Translate はショップ固有名 name から汎用名のアイテムを逆引きします。
+// shop が空の場合はすべてのショップの固有名から探します。
+// 表記ゆれ（ひらがな/カタカナ、長音記号など）は NormalizeName で吸収します。
+// 結果はアイテムごとに1件で、完全一致・ショップの表示順・カタログの順に並びます。
+func (c *Catalog) Translate(shop, name string) []Translation {
+	var results []Translation
+	for _, ref := range c.names[NormalizeName(name)] {
+		if shop != "" && ref.shop != shop {
+			continue
+		}
+		item := c.Items[ref.item]
+		s, _ := c.Shop(ref.shop)
+		results = append(results, Translation{
+			Item:  item,
+			Shop:  s,
+			Name:  item.ShopNames[ref.shop],
+			Exact: item.ShopNames[ref.shop] == name,
+		})
+	}
+
+	slices.SortStableFunc(results, func(a, b Translation) int {
+		if a.Exact != b.Exact {
+			if a.Exact {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(c.shopIndex(a.Shop.Key), c.shopIndex(b.Shop.Key)), cmp.Compare(c.index[a.Item.Name], c.index[b.Item.Name]))
+	})
+
+	// 同じアイテムが複数のショップで一致した場合は、最もよく一致したものだけを残す
+	seen := map[string]bool{}
+	return slices.DeleteFunc(results, func(t Translation) bool {
+		dup := seen[t.Item.Name]
+		seen[t.Item.Name] = true
+		return dup
+	})
+}
@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+func TestCatalog_Translate(t *testing.T) {
+	catalog := domain.DefaultCatalog()
+
+	tests := []struct {
+		name      string
+		shop      string
+		query     string
+		wantItem  string
+		wantShop  string
+		wantExact bool
+	}{
+		{name: "西松屋のプレオール", shop: "nishimatsuya", query: "プレオール", wantItem: "カバーオール", wantShop: "nishimatsuya", wantExact: true},
+		{name: "ショップ指定なし", query: "ドレスオール", wantItem: "カバーオール", wantShop: "akachan_honpo", wantExact: true},
+		{name: "長音記号の表記ゆれ", shop: "nishimatsuya", query: "ボディースーツ", wantItem: "ボディースーツ", wantShop: "nishimatsuya", wantExact: false},
+		{name: "ひらがな", query: "ろんぱす", wantItem: "ロンパース", wantShop: "nishimatsuya", wantExact: false},
+		{name: "複数ショップで同名なら表示順で先のショップ", query: "短肌着", wantItem: "短肌着", wantShop: "nishimatsuya", wantExact: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := catalog.Translate(tt.shop, tt.query)
+			if len(got) != 1 {
+				t.Fatalf("Translate(%q, %q) returned %d results, want 1: %+v", tt.shop, tt.query, len(got), got)
+			}
+			if got[0].Item.Name != tt.wantItem || got[0].Shop.Key != tt.wantShop || got[0].Exact != tt.wantExact {
+				t.Errorf("Translate(%q, %q) = {item: %q, shop: %q, exact: %v}, want {%q, %q, %v}",
+					tt.shop, tt.query, got[0].Item.Name, got[0].Shop.Key, got[0].Exact, tt.wantItem, tt.wantShop, tt.wantExact)
+			}
+		})
+	}
+
+	// ショップを指定した場合は、そのショップの固有名だけから探す
+	if got := catalog.Translate("uniqlo", "プレオール"); len(got) != 0 {
+		t.Errorf("Translate(uniqlo, プレオール) = %+v, want no results", got)
+	}
+}
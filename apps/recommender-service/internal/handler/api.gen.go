@@ -19,6 +19,152 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for Layer.
+const (
+	Accessory Layer = "accessory"
+	Inner     Layer = "inner"
+	Middle    Layer = "middle"
+	Outer     Layer = "outer"
+)
+
+// Defines values for OutfitContext.
+const (
+	Indoor OutfitContext = "indoor"
+	Outing OutfitContext = "outing"
+	Sleep  OutfitContext = "sleep"
+)
+
+// Defines values for ProblemCode.
+const (
+	BirthDateOutOfRange   ProblemCode = "birth_date_out_of_range"
+	DateOutOfRange        ProblemCode = "date_out_of_range"
+	DueDateOutOfRange     ProblemCode = "due_date_out_of_range"
+	InvalidMeasurement    ProblemCode = "invalid_measurement"
+	InvalidMilestoneRange ProblemCode = "invalid_milestone_range"
+	InvalidParameter      ProblemCode = "invalid_parameter"
+	InvalidTemperature    ProblemCode = "invalid_temperature"
+	ItemNotFound          ProblemCode = "item_not_found"
+	UnknownRegion         ProblemCode = "unknown_region"
+	UnsupportedLanguage   ProblemCode = "unsupported_language"
+)
+
+// Defines values for ReasonTemperatureBasis.
+const (
+	Mean ReasonTemperatureBasis = "mean"
+	Min  ReasonTemperatureBasis = "min"
+)
+
+// Defines values for Season.
+const (
+	Autumn      Season = "autumn"
+	RainySeason Season = "rainy_season"
+	Spring      Season = "spring"
+	Summer      Season = "summer"
+	Winter      Season = "winter"
+)
+
+// Defines values for Sex.
+const (
+	Female Sex = "female"
+	Male   Sex = "male"
+)
+
+// Defines values for TemperatureBand.
+const (
+	Chilly TemperatureBand = "chilly"
+	Cold   TemperatureBand = "cold"
+	Cool   TemperatureBand = "cool"
+	Hot    TemperatureBand = "hot"
+	Mild   TemperatureBand = "mild"
+)
+
+// Defines values for TemperatureSource.
+const (
+	Estimated TemperatureSource = "estimated"
+	Specified TemperatureSource = "specified"
+)
+
+// Defines values for TemperatureUnit.
+const (
+	Celsius    TemperatureUnit = "celsius"
+	Fahrenheit TemperatureUnit = "fahrenheit"
+)
+
+// Defines values for GetMilestonesParamsGranularity.
+const (
+	Monthly GetMilestonesParamsGranularity = "monthly"
+	Weekly  GetMilestonesParamsGranularity = "weekly"
+)
+
+// AlternativeOutfit defines model for AlternativeOutfit.
+type AlternativeOutfit struct {
+	// Items Items of the alternative outfit, from the inner layer out. Swapped-in items carry the reason of the item they replace.
+	Items []Item `json:"items"`
+
+	// Substitutions Swaps applied to the outfit
+	Substitutions []Substitution `json:"substitutions"`
+
+	// Warmth Total warmth of the items in clo
+	Warmth float64 `json:"warmth"`
+}
+
+// DailyOutfitResponse What to wear on one date. temperature is the outdoor temperature used (estimated for the date and region, or the temperature parameter; always in ℃); each outfit carries the temperature of its own context. The other fields are the same as in Milestone.
+type DailyOutfitResponse struct {
+	// AgeInMonths Chronological age in months on the date
+	AgeInMonths int `json:"age_in_months"`
+
+	// CorrectedAgeInMonths Corrected age in months on the date (see Milestone)
+	CorrectedAgeInMonths int `json:"corrected_age_in_months"`
+
+	// Date The date the outfit is for
+	Date openapi_types.Date `json:"date"`
+
+	// EstimatedHeightCm Height projected along the baby's growth percentile on the date. Present only when height_cm or weight_kg is given.
+	EstimatedHeightCm *float64 `json:"estimated_height_cm,omitempty"`
+
+	// EstimatedTemperature Daily temperature (℃)
+	EstimatedTemperature Temperature `json:"estimated_temperature"`
+
+	// Items Items of the outing outfit (same as Milestone.items)
+	Items []Item `json:"items"`
+
+	// Outfits Outfits for each context, in the order indoor, outing, sleep
+	Outfits []Outfit `json:"outfits"`
+
+	// Season Season of the date in the region: spring (Mar-May), summer (Jun-Aug), autumn (Sep-Nov) and winter (Dec-Feb), except that the region's usual rainy season (梅雨, from its normal start to end dates) is rainy_season. Hokkaido has no rainy season.
+	Season Season `json:"season"`
+
+	// SeasonLabel Localized name of the season
+	SeasonLabel string `json:"season_label"`
+
+	// Size Estimated clothing size in cm (legacy free-form label kept for compatibility; prefer size_detail). Every size above 90cm stays "90cm+" here; size_detail tells 90-95, 100, 110 and 120 apart.
+	Size string `json:"size"`
+
+	// SizeDetail Clothing size as a range of Japanese size labels (cm). A Japanese size fits babies about ±5cm around the label, so size 80 fits 75-85cm.
+	SizeDetail Size `json:"size_detail"`
+
+	// Temperature Daily temperature (℃)
+	Temperature Temperature `json:"temperature"`
+
+	// TemperatureBand Rough band of the temperature used (mean). The boundaries come from the rule set's temperature_bands and line up with its rule thresholds; with the default rules they are cold (below 10℃), chilly (10-15℃), cool (15-20℃), mild (20-25℃) and hot (25℃ and above).
+	TemperatureBand TemperatureBand `json:"temperature_band"`
+
+	// TemperatureBandLabel Localized name of the temperature band
+	TemperatureBandLabel string `json:"temperature_band_label"`
+
+	// TemperatureSource Where the temperature came from. estimated is the estimate for the date and region; specified is the temperature parameter.
+	TemperatureSource TemperatureSource `json:"temperature_source"`
+}
+
+// Equivalent An item that can replace another item of the same layer
+type Equivalent struct {
+	DisplayName   string `json:"display_name"`
+	UniversalName string `json:"universal_name"`
+
+	// Warmth Warmth of the item in clo
+	Warmth float64 `json:"warmth"`
+}
+
 // Item defines model for Item.
 type Item struct {
 	// CategoryColor Background color for item icon
@@ -30,36 +176,345 @@ type Item struct {
 	// CategoryLabel Category label for display
 	CategoryLabel string `json:"category_label"`
 
+	// Description Short description of the item in the requested language
+	Description *string `json:"description,omitempty"`
+
+	// DisplayName Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+	DisplayName string `json:"display_name"`
+
+	// Equivalents Items of the same layer and about the same warmth (within 0.1 clo) that can be worn instead, in catalog order
+	Equivalents []Equivalent `json:"equivalents"`
+
+	// Layer Clothing layer, from the inside out: inner (underwear), middle (clothes worn over it), outer (worn over clothes, including sleep sacks) and accessory (hats, socks, ...)
+	Layer *Layer `json:"layer,omitempty"`
+
+	// Reason Why the item was recommended
+	Reason Reason `json:"reason"`
+
+	// Replaces Item chosen by the rules that this warmer item of the same layer replaced because the outfit was below its target warmth. Omitted when the item was not replaced.
+	Replaces *string `json:"replaces,omitempty"`
+
 	// ShopNames Shop-specific names of the item
 	ShopNames []ShopNameStatus `json:"shop_names"`
 
 	// UniversalName Universal name of the item (e.g., combi-hadagi)
 	UniversalName string `json:"universal_name"`
+
+	// Warmth Warmth of the item in clo (0 when unknown)
+	Warmth float64 `json:"warmth"`
+}
+
+// ItemCondition Age and temperature ranges in which one rule recommends an item
+type ItemCondition struct {
+	// AgeRange Half-open range [min, max). An omitted bound is unbounded.
+	AgeRange Range  `json:"age_range"`
+	RuleId   string `json:"rule_id"`
+
+	// TemperatureRange Half-open range [min, max). An omitted bound is unbounded.
+	TemperatureRange Range `json:"temperature_range"`
+}
+
+// ItemDetail defines model for ItemDetail.
+type ItemDetail struct {
+	CategoryColor string `json:"category_color"`
+	CategoryEmoji string `json:"category_emoji"`
+	CategoryKey   string `json:"category_key"`
+	CategoryLabel string `json:"category_label"`
+
+	// Conditions When the item is recommended: the age and temperature ranges of each rule in rule_ids, in the same order. The ranges are not merged, so an item recommended on cold and hot days (such as 帽子) has two separate temperature ranges.
+	Conditions []ItemCondition `json:"conditions"`
+
+	// Description Short description of the item in the requested language
+	Description *string `json:"description,omitempty"`
+
+	// DisplayName Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+	DisplayName string `json:"display_name"`
+
+	// Layer Clothing layer, from the inside out: inner (underwear), middle (clothes worn over it), outer (worn over clothes, including sleep sacks) and accessory (hats, socks, ...)
+	Layer *Layer `json:"layer,omitempty"`
+
+	// RuleIds Rules that recommend the item. Empty when no rule recommends it.
+	RuleIds []string `json:"rule_ids"`
+
+	// ShopNames Names of the item at every shop, in shop display order
+	ShopNames     []ShopName `json:"shop_names"`
+	UniversalName string     `json:"universal_name"`
+
+	// Warmth Warmth of the item in clo (0 when unknown)
+	Warmth float64 `json:"warmth"`
+}
+
+// ItemListResponse defines model for ItemListResponse.
+type ItemListResponse struct {
+	Items []ItemDetail `json:"items"`
+}
+
+// ItemTranslation defines model for ItemTranslation.
+type ItemTranslation struct {
+	CategoryColor string `json:"category_color"`
+	CategoryEmoji string `json:"category_emoji"`
+	CategoryLabel string `json:"category_label"`
+
+	// Description Short description of the item in the requested language
+	Description *string `json:"description,omitempty"`
+
+	// DisplayName Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+	DisplayName string `json:"display_name"`
+
+	// Equivalents Names of the same item at every other shop, in shop display order
+	Equivalents []ShopName `json:"equivalents"`
+
+	// Exact False when the query matched only after normalizing spelling variants
+	Exact bool `json:"exact"`
+
+	// MatchedName The shop-specific name that matched the query
+	MatchedName string `json:"matched_name"`
+
+	// MatchedShopKey Shop whose name matched the query
+	MatchedShopKey string `json:"matched_shop_key"`
+	UniversalName  string `json:"universal_name"`
 }
 
-// Milestone defines model for Milestone.
+// Layer Clothing layer, from the inside out: inner (underwear), middle (clothes worn over it), outer (worn over clothes, including sleep sacks) and accessory (hats, socks, ...)
+type Layer string
+
+// Milestone Size and outfits at one point in time. temperature is the outdoor temperature the outfits were chosen for (℃); estimated_temperature is the temperature estimated for the date and region, which differs from temperature only when the temperature parameter is given. season and temperature_band explain the outfits at a glance.
 type Milestone struct {
-	// AgeInMonths Age in months at this milestone
+	// AgeInMonths Chronological age in months (counted from the birth date) at this milestone
 	AgeInMonths int `json:"age_in_months"`
 
-	// Items List of recommended items
+	// AgeInWeeks Age in weeks (counted from the birth date). Present only for the weekly milestones of granularity=weekly.
+	AgeInWeeks *int `json:"age_in_weeks,omitempty"`
+
+	// CorrectedAgeInMonths Corrected age in months (counted from the due date) at this milestone. Equals age_in_months when no due date is given or the baby was not born early. Used to choose the size and items.
+	CorrectedAgeInMonths int `json:"corrected_age_in_months"`
+
+	// EstimatedHeightCm Height projected along the baby's growth percentile at this milestone. Present only when height_cm or weight_kg is given.
+	EstimatedHeightCm *float64 `json:"estimated_height_cm,omitempty"`
+
+	// EstimatedTemperature Daily temperature (℃)
+	EstimatedTemperature Temperature `json:"estimated_temperature"`
+
+	// Items List of recommended items. Same as the items of the outing outfit; kept for compatibility (prefer outfits).
 	Items []Item `json:"items"`
 
-	// Size Estimated clothing size in cm
+	// Outfits One outfit per context, in the order indoor, outing, sleep
+	Outfits []Outfit `json:"outfits"`
+
+	// Season Season of the date in the region: spring (Mar-May), summer (Jun-Aug), autumn (Sep-Nov) and winter (Dec-Feb), except that the region's usual rainy season (梅雨, from its normal start to end dates) is rainy_season. Hokkaido has no rainy season.
+	Season Season `json:"season"`
+
+	// SeasonLabel Localized name of the season
+	SeasonLabel string `json:"season_label"`
+
+	// Size Estimated clothing size in cm (legacy free-form label kept for compatibility; prefer size_detail). Every size above 90cm stays "90cm+" here; size_detail tells 90-95, 100, 110 and 120 apart.
 	Size string `json:"size"`
 
+	// SizeDetail Clothing size as a range of Japanese size labels (cm). A Japanese size fits babies about ±5cm around the label, so size 80 fits 75-85cm.
+	SizeDetail Size `json:"size_detail"`
+
 	// TargetDate The date corresponding to this milestone
 	TargetDate openapi_types.Date `json:"target_date"`
+
+	// Temperature Daily temperature (℃)
+	Temperature Temperature `json:"temperature"`
+
+	// TemperatureBand Rough band of the temperature used (mean). The boundaries come from the rule set's temperature_bands and line up with its rule thresholds; with the default rules they are cold (below 10℃), chilly (10-15℃), cool (15-20℃), mild (20-25℃) and hot (25℃ and above).
+	TemperatureBand TemperatureBand `json:"temperature_band"`
+
+	// TemperatureBandLabel Localized name of the temperature band
+	TemperatureBandLabel string `json:"temperature_band_label"`
+
+	// TemperatureSource Where the temperature came from. estimated is the estimate for the date and region; specified is the temperature parameter.
+	TemperatureSource TemperatureSource `json:"temperature_source"`
 }
 
 // MilestoneResponse defines model for MilestoneResponse.
 type MilestoneResponse struct {
-	// Milestones List of milestones from birth to 24 months
+	// Milestones List of milestones in date order (from birth to 24 months by default)
 	Milestones []Milestone `json:"milestones"`
 }
 
-// ShopNameStatus defines model for ShopNameStatus.
+// Offer A concrete product that can be bought at a shop
+type Offer struct {
+	// Jan JAN (EAN) code
+	Jan      *string `json:"jan,omitempty"`
+	Material *string `json:"material,omitempty"`
+
+	// Price Price range including tax
+	Price PriceRange `json:"price"`
+
+	// Sizes Sizes (cm) the product is sold in
+	Sizes []int `json:"sizes"`
+
+	// Sku Shop's product number
+	Sku *string `json:"sku,omitempty"`
+
+	// Url Product page URL
+	Url string `json:"url"`
+}
+
+// Outfit Recommended outfit for one context. Each context has its own temperature: indoors is assumed to be heated or cooled to 20-24℃, outings use the outdoor temperature, and sleep uses the bedroom temperature at night (18-24℃, following the overnight low).
+type Outfit struct {
+	// Alternatives Other outfits made by swapping items with the rule set's substitution table, best first (fewest swaps, then the least warmth above what is required). Swapped-in items are only ones the rules recommend for the context at this age and that some shop sells in the milestone's size. Every alternative reaches target_warmth, or the outfit's own warmth when the outfit falls short of the target. At most 3.
+	Alternatives []AlternativeOutfit `json:"alternatives"`
+
+	// Context Where the baby wears the outfit
+	Context OutfitContext `json:"context"`
+
+	// Items Recommended items for this context, from the inner layer out. The sleep outfit adds a sleep sack instead of a blanket when the bedroom is cool.
+	Items []Item `json:"items"`
+
+	// Label Localized name of the context
+	Label string `json:"label"`
+
+	// TargetWarmth Warmth (clo) the outfit should reach for the context's mean temperature and the baby's age. When warmth is below it even after replacing items with warmer ones, add a blanket or avoid going out.
+	TargetWarmth float64 `json:"target_warmth"`
+
+	// Temperature Daily temperature (℃)
+	Temperature Temperature `json:"temperature"`
+
+	// Warmth Total warmth of the items in clo
+	Warmth float64 `json:"warmth"`
+}
+
+// OutfitContext Where the baby wears the outfit
+type OutfitContext string
+
+// PriceRange Price range including tax
+type PriceRange struct {
+	Currency string `json:"currency"`
+	Max      int    `json:"max"`
+	Min      int    `json:"min"`
+}
+
+// Problem Error response in the RFC 7807 problem details format (application/problem+json)
+type Problem struct {
+	// Code Stable error code. invalid_parameter: a parameter is missing or malformed (wrong type, unknown enum value, unknown shop, limit out of range). birth_date_out_of_range: birth_date is more than 72 months ago or more than 44 weeks ahead. due_date_out_of_range: due_date is unrealistic for birth_date. date_out_of_range: date is before birth_date or more than 72 months after it. invalid_milestone_range: from_month, to_month or step is out of range. invalid_measurement: height_cm, weight_kg, sex or measured_on is unrealistic. invalid_temperature: temperature is out of the accepted range. unknown_region: region does not match any region or prefecture. unsupported_language: lang is not a supported language. item_not_found: no catalog item matches (404).
+	Code ProblemCode `json:"code"`
+
+	// Detail Human-readable explanation of this occurrence (English, not stable)
+	Detail *string `json:"detail,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title HTTP status text
+	Title string `json:"title"`
+
+	// Type Always "about:blank"; use code to tell errors apart
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable error code. invalid_parameter: a parameter is missing or malformed (wrong type, unknown enum value, unknown shop, limit out of range). birth_date_out_of_range: birth_date is more than 72 months ago or more than 44 weeks ahead. due_date_out_of_range: due_date is unrealistic for birth_date. date_out_of_range: date is before birth_date or more than 72 months after it. invalid_milestone_range: from_month, to_month or step is out of range. invalid_measurement: height_cm, weight_kg, sex or measured_on is unrealistic. invalid_temperature: temperature is out of the accepted range. unknown_region: region does not match any region or prefecture. unsupported_language: lang is not a supported language. item_not_found: no catalog item matches (404).
+type ProblemCode string
+
+// Range Half-open range [min, max). An omitted bound is unbounded.
+type Range struct {
+	// Max Exclusive upper bound
+	Max *float64 `json:"max,omitempty"`
+
+	// Min Inclusive lower bound
+	Min *float64 `json:"min,omitempty"`
+}
+
+// Reason Why the item was recommended
+type Reason struct {
+	// AgeBand Half-open range [min, max). An omitted bound is unbounded.
+	AgeBand Range `json:"age_band"`
+
+	// Message Human-readable explanation in the display language (see lang)
+	Message string `json:"message"`
+
+	// RuleGroup ID of the rule group (e.g., inner, layer) the rule belongs to
+	RuleGroup string `json:"rule_group"`
+
+	// RuleId ID of the recommendation rule that matched
+	RuleId string `json:"rule_id"`
+
+	// TargetWarmth Set only when the item replaced a lighter one because the outfit was below its target warmth: the target (clo) it was chosen to reach. The rule is then the one that recommends this item at the band closest to the temperature.
+	TargetWarmth *float64 `json:"target_warmth,omitempty"`
+
+	// Temperature Temperature (℃) the rule was evaluated with
+	Temperature float64 `json:"temperature"`
+
+	// TemperatureBand Half-open range [min, max). An omitted bound is unbounded.
+	TemperatureBand Range `json:"temperature_band"`
+
+	// TemperatureBasis Which daily temperature was used (mean = daily mean, min = morning/evening low)
+	TemperatureBasis ReasonTemperatureBasis `json:"temperature_basis"`
+}
+
+// ReasonTemperatureBasis Which daily temperature was used (mean = daily mean, min = morning/evening low)
+type ReasonTemperatureBasis string
+
+// SearchResponse defines model for SearchResponse.
+type SearchResponse struct {
+	// NormalizedQuery The query after normalization
+	NormalizedQuery string         `json:"normalized_query"`
+	Query           string         `json:"query"`
+	Results         []SearchResult `json:"results"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	CategoryColor string `json:"category_color"`
+	CategoryEmoji string `json:"category_emoji"`
+	CategoryLabel string `json:"category_label"`
+
+	// Description Short description of the item in the requested language
+	Description *string `json:"description,omitempty"`
+
+	// DisplayName Item name in the requested language. Non-Japanese names carry the Japanese universal name in parentheses.
+	DisplayName string `json:"display_name"`
+
+	// MatchedShopKey Set when the best match was a shop-specific name
+	MatchedShopKey *string `json:"matched_shop_key,omitempty"`
+
+	// MatchedText The name, reading or category label that matched best
+	MatchedText string `json:"matched_text"`
+
+	// Score Similarity between 0 and 1 (1 is an exact match)
+	Score float64 `json:"score"`
+
+	// ShopNames Names of the item at every shop, in shop display order
+	ShopNames     []ShopName `json:"shop_names"`
+	UniversalName string     `json:"universal_name"`
+}
+
+// Season Season of the date in the region: spring (Mar-May), summer (Jun-Aug), autumn (Sep-Nov) and winter (Dec-Feb), except that the region's usual rainy season (梅雨, from its normal start to end dates) is rainy_season. Hokkaido has no rainy season.
+type Season string
+
+// Sex Sex used to pick the growth curve
+type Sex string
+
+// ShopName defines model for ShopName.
+type ShopName struct {
+	DisplayName string `json:"display_name"`
+	ShopKey     string `json:"shop_key"`
+	ShopName    string `json:"shop_name"`
+}
+
+// ShopNameStatus The item's name at one shop. Entries are always ordered by the shop's priority.
 type ShopNameStatus struct {
+	// Available Whether the shop sells this item in the milestone's size
+	Available bool `json:"available"`
+
+	// BrandColor Brand color of the shop (#RRGGBB), if registered
+	BrandColor *string `json:"brand_color,omitempty"`
+
+	// DisplayName Display name of the shop
+	DisplayName string `json:"display_name"`
+
+	// LogoUrl URL of the shop logo, if registered
+	LogoUrl *string `json:"logo_url,omitempty"`
+
+	// Offers Purchasable products at this shop in the milestone's size. Empty when none are registered. The built-in catalog ships no products, so this stays empty until the server is started with a catalog file (CATALOG_FILE) that lists them.
+	Offers []Offer `json:"offers"`
+
+	// Priority Display order of the shop (smaller comes first)
+	Priority int `json:"priority"`
+
 	// ShopKey Unique key for the shop
 	ShopKey string `json:"shop_key"`
 
@@ -67,17 +522,233 @@ type ShopNameStatus struct {
 	ShopName string `json:"shop_name"`
 }
 
+// Size Clothing size as a range of Japanese size labels (cm). A Japanese size fits babies about ±5cm around the label, so size 80 fits 75-85cm.
+type Size struct {
+	// Conversions Equivalent sizes in other size systems, smallest first
+	Conversions SizeConversions `json:"conversions"`
+
+	// Label Canonical size label
+	Label string `json:"label"`
+
+	// MaxCm Largest Japanese size label in the range (cm)
+	MaxCm int `json:"max_cm"`
+
+	// MinCm Smallest Japanese size label in the range (cm)
+	MinCm int `json:"min_cm"`
+}
+
+// SizeConversions Equivalent sizes in other size systems, smallest first
+type SizeConversions struct {
+	// Eu EU sizes (body height in cm)
+	Eu []string `json:"eu"`
+
+	// Us US baby sizes (months / toddler)
+	Us []string `json:"us"`
+}
+
+// Substitution A set of items replaced together by another set of items
+type Substitution struct {
+	From []string `json:"from"`
+	To   []string `json:"to"`
+}
+
+// Temperature Daily temperature (℃)
+type Temperature struct {
+	// Max Daily high (daytime)
+	Max float64 `json:"max"`
+
+	// Mean Daily mean
+	Mean float64 `json:"mean"`
+
+	// Min Daily low (morning/evening)
+	Min float64 `json:"min"`
+}
+
+// TemperatureBand Rough band of the temperature used (mean). The boundaries come from the rule set's temperature_bands and line up with its rule thresholds; with the default rules they are cold (below 10℃), chilly (10-15℃), cool (15-20℃), mild (20-25℃) and hot (25℃ and above).
+type TemperatureBand string
+
+// TemperatureSource Where the temperature came from. estimated is the estimate for the date and region; specified is the temperature parameter.
+type TemperatureSource string
+
+// TemperatureUnit Temperature unit (celsius = ℃, fahrenheit = ℉)
+type TemperatureUnit string
+
+// TranslationResponse defines model for TranslationResponse.
+type TranslationResponse struct {
+	// Matches Matching items, best match first (one entry per universal item)
+	Matches []ItemTranslation `json:"matches"`
+}
+
+// BirthDate defines model for BirthDate.
+type BirthDate = openapi_types.Date
+
+// DueDate defines model for DueDate.
+type DueDate = openapi_types.Date
+
+// HeightCm defines model for HeightCm.
+type HeightCm = float64
+
+// Lang defines model for Lang.
+type Lang = string
+
+// MeasuredOn defines model for MeasuredOn.
+type MeasuredOn = openapi_types.Date
+
+// Region defines model for Region.
+type Region = string
+
+// SpecifiedTemperature defines model for SpecifiedTemperature.
+type SpecifiedTemperature = float64
+
+// WeightKg defines model for WeightKg.
+type WeightKg = float64
+
+// BadRequest Error response in the RFC 7807 problem details format (application/problem+json)
+type BadRequest = Problem
+
+// NotFound Error response in the RFC 7807 problem details format (application/problem+json)
+type NotFound = Problem
+
+// ListItemsParams defines parameters for ListItems.
+type ListItemsParams struct {
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// SearchItemsParams defines parameters for SearchItems.
+type SearchItemsParams struct {
+	// Q Search text. A single-character query only returns items with exactly that name.
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// TranslateItemParams defines parameters for TranslateItem.
+type TranslateItemParams struct {
+	// Name Shop-specific item name to translate
+	Name string `form:"name" json:"name"`
+
+	// Shop Shop key the name comes from. All shops are searched when omitted.
+	Shop *string `form:"shop,omitempty" json:"shop,omitempty"`
+
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// GetItemParams defines parameters for GetItem.
+type GetItemParams struct {
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
 // GetMilestonesParams defines parameters for GetMilestones.
 type GetMilestonesParams struct {
-	// BirthDate Baby's birth date (YYYY-MM-DD)
-	BirthDate openapi_types.Date `form:"birth_date" json:"birth_date"`
+	// BirthDate Baby's birth date (YYYY-MM-DD). Must be at most 72 months ago and at most 44 weeks ahead (an expected birth date can be used to prepare before the birth).
+	BirthDate BirthDate `form:"birth_date" json:"birth_date"`
+
+	// Region Region used to pick the climate profile. Accepts a region key (hokkaido, tohoku, kanto, hokuriku, tokai, kinki, chugoku, shikoku, kyushu, okinawa), a prefecture key (e.g. "osaka") or a prefecture name (e.g. "大阪府"). Defaults to kanto (Tokyo).
+	Region *Region `form:"region,omitempty" json:"region,omitempty"`
+
+	// DueDate Baby's due date (YYYY-MM-DD). When the baby was born before the due date, sizes and items are chosen by corrected age (months counted from the due date).
+	DueDate *DueDate `form:"due_date,omitempty" json:"due_date,omitempty"`
+
+	// HeightCm Baby's height in cm at the last measurement (e.g., a checkup). When given, the size is chosen from the height projected along the baby's growth percentile instead of from age alone.
+	HeightCm *HeightCm `form:"height_cm,omitempty" json:"height_cm,omitempty"`
+
+	// WeightKg Baby's weight in kg at the last measurement. Used as a proxy for the growth percentile when height_cm is not given.
+	WeightKg *WeightKg `form:"weight_kg,omitempty" json:"weight_kg,omitempty"`
+
+	// Sex Sex used to pick the growth curve. The average of both curves is used when omitted.
+	Sex *Sex `form:"sex,omitempty" json:"sex,omitempty"`
+
+	// MeasuredOn Date of the height/weight measurement (YYYY-MM-DD). Defaults to today.
+	MeasuredOn *MeasuredOn `form:"measured_on,omitempty" json:"measured_on,omitempty"`
+
+	// Temperature Daily mean temperature to dress for, e.g. from a weather forecast. When given, it is used for every milestone instead of the temperature estimated from the monthly normals; the daily low and high keep the usual day-night range for the date.
+	Temperature *SpecifiedTemperature `form:"temperature,omitempty" json:"temperature,omitempty"`
+
+	// TemperatureUnit Unit of temperature. Defaults to celsius. Ignored when temperature is omitted, but an unknown unit is still rejected.
+	TemperatureUnit *TemperatureUnit `form:"temperature_unit,omitempty" json:"temperature_unit,omitempty"`
+
+	// FromMonth Age in months of the first milestone. Defaults to 0.
+	FromMonth *int `form:"from_month,omitempty" json:"from_month,omitempty"`
+
+	// ToMonth Age in months of the last milestone (inclusive). Defaults to 24. Must not be less than from_month.
+	ToMonth *int `form:"to_month,omitempty" json:"to_month,omitempty"`
+
+	// Step Interval in months between monthly milestones. Defaults to 1.
+	Step *int `form:"step,omitempty" json:"step,omitempty"`
+
+	// Granularity monthly (default) returns a milestone every step months. weekly returns a milestone every week until 3 months old, when sizes change fastest, and monthly milestones after that. A weekly milestone less than a week before the next monthly one (such as week 13 right before month 3) is left out.
+	Granularity *GetMilestonesParamsGranularity `form:"granularity,omitempty" json:"granularity,omitempty"`
+
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
+}
+
+// GetMilestonesParamsGranularity defines parameters for GetMilestones.
+type GetMilestonesParamsGranularity string
+
+// GetOutfitParams defines parameters for GetOutfit.
+type GetOutfitParams struct {
+	// BirthDate Baby's birth date (YYYY-MM-DD). Must be at most 72 months ago and at most 44 weeks ahead (an expected birth date can be used to prepare before the birth).
+	BirthDate BirthDate `form:"birth_date" json:"birth_date"`
+
+	// Date Date to dress for (YYYY-MM-DD). Defaults to today. Must be between the birth date and 72 months after it.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// Region Region used to pick the climate profile. Accepts a region key (hokkaido, tohoku, kanto, hokuriku, tokai, kinki, chugoku, shikoku, kyushu, okinawa), a prefecture key (e.g. "osaka") or a prefecture name (e.g. "大阪府"). Defaults to kanto (Tokyo).
+	Region *Region `form:"region,omitempty" json:"region,omitempty"`
+
+	// DueDate Baby's due date (YYYY-MM-DD). When the baby was born before the due date, sizes and items are chosen by corrected age (months counted from the due date).
+	DueDate *DueDate `form:"due_date,omitempty" json:"due_date,omitempty"`
+
+	// HeightCm Baby's height in cm at the last measurement (e.g., a checkup). When given, the size is chosen from the height projected along the baby's growth percentile instead of from age alone.
+	HeightCm *HeightCm `form:"height_cm,omitempty" json:"height_cm,omitempty"`
+
+	// WeightKg Baby's weight in kg at the last measurement. Used as a proxy for the growth percentile when height_cm is not given.
+	WeightKg *WeightKg `form:"weight_kg,omitempty" json:"weight_kg,omitempty"`
+
+	// Sex Sex used to pick the growth curve. The average of both curves is used when omitted.
+	Sex *Sex `form:"sex,omitempty" json:"sex,omitempty"`
+
+	// MeasuredOn Date of the height/weight measurement (YYYY-MM-DD). Defaults to today.
+	MeasuredOn *MeasuredOn `form:"measured_on,omitempty" json:"measured_on,omitempty"`
+
+	// Temperature Daily mean temperature to dress for, e.g. from a weather forecast. When given, it is used for every milestone instead of the temperature estimated from the monthly normals; the daily low and high keep the usual day-night range for the date.
+	Temperature *SpecifiedTemperature `form:"temperature,omitempty" json:"temperature,omitempty"`
+
+	// TemperatureUnit Unit of temperature. Defaults to celsius. Ignored when temperature is omitted, but an unknown unit is still rejected.
+	TemperatureUnit *TemperatureUnit `form:"temperature_unit,omitempty" json:"temperature_unit,omitempty"`
+
+	// Lang Display language for item names, descriptions and category labels (ja, en, zh, ko, vi; region subtags such as "en-US" are accepted). Takes precedence over the Accept-Language header. Defaults to ja. Shop-specific names are always Japanese, as shown in the store.
+	Lang *Lang `form:"lang,omitempty" json:"lang,omitempty"`
 }
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List catalog items
+	// (GET /items)
+	ListItems(c *gin.Context, params ListItemsParams)
+	// Search items
+	// (GET /items/search)
+	SearchItems(c *gin.Context, params SearchItemsParams)
+	// Translate a shop-specific item name
+	// (GET /items/translate)
+	TranslateItem(c *gin.Context, params TranslateItemParams)
+	// Get a catalog item
+	// (GET /items/{universal_name})
+	GetItem(c *gin.Context, universalName string, params GetItemParams)
 	// Get baby wear milestones
 	// (GET /milestones)
 	GetMilestones(c *gin.Context, params GetMilestonesParams)
+	// Get the outfit for a single date
+	// (GET /outfit)
+	GetOutfit(c *gin.Context, params GetOutfitParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -89,6 +760,165 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// ListItems operation middleware
+func (siw *ServerInterfaceWrapper) ListItems(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListItemsParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListItems(c, params)
+}
+
+// SearchItems operation middleware
+func (siw *ServerInterfaceWrapper) SearchItems(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchItemsParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchItems(c, params)
+}
+
+// TranslateItem operation middleware
+func (siw *ServerInterfaceWrapper) TranslateItem(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TranslateItemParams
+
+	// ------------- Required query parameter "name" -------------
+
+	if paramValue := c.Query("name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "shop" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "shop", c.Request.URL.Query(), &params.Shop)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shop: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TranslateItem(c, params)
+}
+
+// GetItem operation middleware
+func (siw *ServerInterfaceWrapper) GetItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "universal_name" -------------
+	var universalName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "universal_name", c.Param("universal_name"), &universalName, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter universal_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemParams
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetItem(c, universalName, params)
+}
+
 // GetMilestones operation middleware
 func (siw *ServerInterfaceWrapper) GetMilestones(c *gin.Context) {
 
@@ -112,6 +942,110 @@ func (siw *ServerInterfaceWrapper) GetMilestones(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", c.Request.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter region: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_date", c.Request.URL.Query(), &params.DueDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter due_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "height_cm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "height_cm", c.Request.URL.Query(), &params.HeightCm)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter height_cm: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "weight_kg" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weight_kg", c.Request.URL.Query(), &params.WeightKg)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter weight_kg: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sex", c.Request.URL.Query(), &params.Sex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sex: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "measured_on" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "measured_on", c.Request.URL.Query(), &params.MeasuredOn)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter measured_on: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "temperature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "temperature", c.Request.URL.Query(), &params.Temperature)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter temperature: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "temperature_unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "temperature_unit", c.Request.URL.Query(), &params.TemperatureUnit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter temperature_unit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from_month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from_month", c.Request.URL.Query(), &params.FromMonth)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from_month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to_month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to_month", c.Request.URL.Query(), &params.ToMonth)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to_month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "step" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "step", c.Request.URL.Query(), &params.Step)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter step: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "granularity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "granularity", c.Request.URL.Query(), &params.Granularity)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter granularity: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -122,6 +1056,119 @@ func (siw *ServerInterfaceWrapper) GetMilestones(c *gin.Context) {
 	siw.Handler.GetMilestones(c, params)
 }
 
+// GetOutfit operation middleware
+func (siw *ServerInterfaceWrapper) GetOutfit(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutfitParams
+
+	// ------------- Required query parameter "birth_date" -------------
+
+	if paramValue := c.Query("birth_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument birth_date is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "birth_date", c.Request.URL.Query(), &params.BirthDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter birth_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", c.Request.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", c.Request.URL.Query(), &params.Region)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter region: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "due_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_date", c.Request.URL.Query(), &params.DueDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter due_date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "height_cm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "height_cm", c.Request.URL.Query(), &params.HeightCm)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter height_cm: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "weight_kg" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weight_kg", c.Request.URL.Query(), &params.WeightKg)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter weight_kg: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sex" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sex", c.Request.URL.Query(), &params.Sex)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sex: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "measured_on" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "measured_on", c.Request.URL.Query(), &params.MeasuredOn)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter measured_on: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "temperature" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "temperature", c.Request.URL.Query(), &params.Temperature)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter temperature: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "temperature_unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "temperature_unit", c.Request.URL.Query(), &params.TemperatureUnit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter temperature_unit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lang" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lang", c.Request.URL.Query(), &params.Lang)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lang: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOutfit(c, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -149,29 +1196,153 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/items", wrapper.ListItems)
+	router.GET(options.BaseURL+"/items/search", wrapper.SearchItems)
+	router.GET(options.BaseURL+"/items/translate", wrapper.TranslateItem)
+	router.GET(options.BaseURL+"/items/:universal_name", wrapper.GetItem)
 	router.GET(options.BaseURL+"/milestones", wrapper.GetMilestones)
+	router.GET(options.BaseURL+"/outfit", wrapper.GetOutfit)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/5xWzWskRRT/Vx6l4Ao9M51klaVvu24igc2y7K5IWEKoqX7TXUnXR6peZx1DQDIXES8i",
-	"HsWLF88LguB/Mwc9+idI1Xx1Tzdj3NMk9fHe7+O913XDhFHWaNTkWXbDvChR8fjnMaEKv9YZi44kxlXB",
-	"CQvjpufCVMaFlRy9cNKSNJpl7AkXl4Uztc4hnoCJcSAJFUhhNEsYfsWVrZBl7IOjo6ODw5QljKY2LHhy",
-	"UhfsNtlkQWUuZDfLYVgGh9ahRx0WwUyASoTVzVamf3754fedaSo+xqqb5rPlPsT9SCWX3la8HX5+9+t8",
-	"9m4++24++7MvjS+NPddcLRRsp3hVGjvwFoWcSAHx0IpKUI0lLPzEix86nATZRhvLRku/RiHMc67wFXGq",
-	"fUi6RMGd49Pwf63lNTrPq4ikC+SL1X4E0cQAD3BYDBMQRo3loOQ5L+THWwK8iwL8+Pfd93/9/E1Xg9uE",
-	"ObyqpcOcZW+2sbQU6rjSqYZkuwjP1vnM+AIFBbonskJPRmO3hHmB51KfK6Op7HHkcYEgNSy2gRNQKT2o",
-	"dbwG8XSdWGrCAl3IvParHfaZ9BRUdSiMUqhzzGFx9J4Wx37sMdbLr3vsPPQkFSfMQVSGSqkLCAcDM6Fa",
-	"5n2SDj5N41qncIm7Auk859ST4XWJEHZAGOfQW6PzkIXMDsHYfrp/MNhLB+keS9jEOMWJZSwm+K+iadvW",
-	"BrdUYaXkzoJ4GbH6nsJYQ95h3+YMTJxRMJaOykB6/yGsod3Lz02FdkzdYt7A1cdsq/c7tGJzXeK0t+ev",
-	"aoRLnMbZFho+HG45Vmt5VZmdY60b+Pn2CFm1USd8HB2z+ezbOEB+ms9+C9P07o//O1LWJJu4umqFa1JP",
-	"TE/bvziOIqz7MxTzmI+n8Ba5gzH3mIPRS8Nj4XOdA6Gy6DjVDhN4K6mMFLcm+jDAlxQJPwkhvwwhXzuu",
-	"fcXJOHj84pglLMzEBZi9YTpMg8bGouZWsowdDNPhAUuY5cupNWqXa4HUJfUSqXbaA4eqZ/xs2MWCjfSR",
-	"i3JRyNv1DVPkzoOp8kDHRNbS6OOcZexzpJMNmgDScYWEzrPsTfeJMJ5+5JtCPjg9PT0dnJwMnj4NHxYZ",
-	"Tl3VGL/iiwJj8fSq2TfGk6sxWb5aAv33nDRnIeZiLEQx99M0PnaMJtRRV25tJUVkPLrwgcdNI+29+nw9",
-	"eGIVbj0DaiHQ+0ldNSeMW99I2MMFpPa1Y33NK5mD1LYmaKgeMvhaKe6mC3saZjfqJiLx6K77nXpmBK9g",
-	"sc8SVruKZawkstloVIW90njKHqWPUnZ7dvtvAAAA//8a0reQTAoAAA==",
+	"H4sIAAAAAAAC/+x9/Y8cx3Xgv1Jo34EzSM/s7C6/tIR/4KdMh6QELgVBEI1FTXfNdGm6q0ZV1TscGQRM",
+	"rpJTcodccsYhh4sCH3y+OHFiOwcDRhJIDnB/igeSzz/x/oRDvarqru6u3p2lKEcJ9BO50/X56tX7fq++",
+	"GyW8WHJGmJLRwXejJRa4IIoI+OsGFSq7hRXRf6REJoIuFeUsOohu4On6gkRT3QKlWBE0eOedd94Z3b8/",
+	"unVrOEb3S6nQlCCsUMGlQlf2UMGZyiTCc44wS6svFy+iFSELiXBGcIoGmCHyZEkSRVJ/+AQzPV4pSYoU",
+	"R0tBllgQNCUzLghSGTGNh+Mojqhe4fslEesojhguSHQQwdcjPVQUR4K8X1JB0uhAiZLEkUwyUmC9S/IE",
+	"F8tcd9ib7O2PdiejyW4URzMuCqyig8gOoNZL3UYqQdk8evo0jm6V5FRIpSUJwentjDCzejxdoxWWaMoF",
+	"87flOsZI0g+IBNhRRQqJ9P6TjEvC0HSNEi6EgRqeEzSw0E54yfRvM8GLxnC9gEpL4sDUD5bd0d5kK7B8",
+	"i9B5pm4WvXDJoAGiDCWFxgm9xhxLhQqCZSlIQZhCAzKej2OEUZKRZFEuHeDm9JiwGPpo4CAqHUCq/drx",
+	"l4K/Z6GTczavQH5BorngK5WhJREJYYrmBFEmlcZFPjPjaIDqbqQPZmaSo6QIA+3y7viSDyxeTnMPXKws",
+	"pkQAuO5hNu+C6haVyxyvUY7ZvNSLmXEBOID0/DJGXnODIAlWZM6F7jIluUSD93CMNKg+yGK04DE6pteQ",
+	"IHPKGZLlVOG5RLJMMoQlehwRNnrr8HEECIaThCwVSYdj9AgviNQ3LyEpYQlB/JgIgOR1aDS659anbzIR",
+	"Y3SLzHCZK6lv7Ht4jA4zvhzJJUnojCZm8WaSfIXXEn0bLzEjksR6GTLjK6bxAk5XcdELfQ2WHmwlLIiV",
+	"9w1upW+wALCxIvrka+TZWcE/TYxs3GJ/n4qneN23VDtEesRZ//26OJrsjiaXtrpfD+EMu7swv9fkkiYL",
+	"2FGS00JvcCn4jOZkbI9OIuzQYUHWaJDxxQLTlMdI8YwvyhgtMFM8RvoPQfUPii8wjdGCsgWNUZKVc2gn",
+	"M7owHdalzMoY8QVleIWH+vouBZmRRJWCmGn0tUaPIy7xAj+OhoiLZiMNtarVZz/68W//208++6ePH0ct",
+	"kMPa0OARX6x5L10z2+uBOqwgCOBD8qQL3UPypAtaS0eSUhyTMXqUEYSPidDXgc/QlLtPUlMp6LzSJIwX",
+	"VCmS9q1akieNJf87QWbRQfSNnZpv75ivckcvFZZsLhhJH5FiSQTWoAzhOc3XGqcZUnU7vaNUECk1jYkR",
+	"gN7QQLQiWGVE6A8kwVI1aTBV1b40dSLHRKxRQXMiFWcNkqph5c9IpAKc9JgUMK98jZjG/1xegx9TWHDO",
+	"V0DhMjrP0IKQJXwrZYlzlOL1iMFVFZhZMml6ql7i4a0kjBtXt6PcHqzfYlR14a1/he3XDZtYnJBc0lKO",
+	"0d0548Lhhw8pKh26xGhaKoQZKtmCaTpZMnMAUtE8R4IYZrfFpo90z61RrL1LvfO3gTr+/ryXxa8qFr+Y",
+	"97H4MXpLow6WQAD4k3V1eF32DHCpWK7eNePK4GHfhs0SjhY9fOLyeG+bU34aR4LIJWeSGPkYpw/J+yWR",
+	"cNwJZ4ow+C9eLnOaYA2GnaXg05wUv/eeNHR6O0C/aXqZSZtQvcuOcU5TRNmyVKiW2A3NSXhKkCJ5LtEq",
+	"o0lmRCY0wzQn6TUkCUF27HH0NI4ecHWHlyz9XW7gATfCS4FVkhGJBrBm/dMR4+poptczBNSyg+m5rueK",
+	"CIYVPSZvlGpmbthS8CURiprj0CPILhbe1T87woPrYRCHceKa7FDGiEA5XhOhP47R4QovlyQdUWZF7gQL",
+	"sYa2gmDJmRsW9qMyskaCLHOcGHLj1nManPTqoqcVrmEh8Fr/LcupVFSVINMFONAKLyWCYzJsSGVuQ9vO",
+	"fOjNEFrBCotCZd2pH3GFc2S++vuXIMTnPIrrizUZX96OfNYa2bt29dUC2rD4TtWfTzWZ02sFZmbw4qG9",
+	"od2Fv51hpUG1IlggfXbMsoY2kbWwTDkXjU/A3QYev/L4CzAlI2PEyP7u963u6TUn7VKGfv3hyfAaIjjJ",
+	"7NkBglEiO935DFElkSb1cE2fKHPduWHJlOSpEaV1R4kLokkpZei+48AaIZvXBc/JEWVHRlHsQutmJjjj",
+	"OZ/TBOegAlHmdHjOqn37p71fnQxlisz10cZRpZcenTVhQ4ENToYGkpB6T8MzJ0+DKvkjN1x9afSxz7iI",
+	"4oAovruFKB5HFVoc1dpgZ+ZvvYQ26u1/jN4URBKmEGf5us0IuUAVn9P7qTiir4huw+j8zaimDLmlcKDH",
+	"2IYc81JRNndnMHCIW2MtDDL8osTUjB9YjCEZ0ois+hrayxU7xZOLlAhEmaYFsV1ujGROyHLbRZk5gjQe",
+	"eMjZYj20qtofgU7f3cs9nuCcfkBSozZZCNs5fLz+7A//LoTA2oLSHfV2Re2SnKtMn5YxtYDBZpCTOU7W",
+	"aCYIGWm8MgYHtCBLBUDV+8GKTmlO1fqa0e0EjHCUEoVpPhyj26ArwKh4yo8Jem2SFEgqTSQfR/qP33sc",
+	"oYwIcs3vaaWc1yaj1y7FaHcyidHu7gTo8O7eBOElFqqB/dHlyejKJCn6Nm/HPfM8NJz0ab70zfDF7ylm",
+	"6Tm639DNA0OcDym83ggW0ECPn/+XzbMPQ0DyJ5W8FMl5Nn5oOrSZvSWnTd7QzzUsmjYPLG5pcYF19pG0",
+	"6hK2blfgkHqBHlcSi6MzIRHl9vslPca5FbCbp3SdOQkSK7B0WykSYWYYPHx1N1ofJAipHZaeGkPhkdF6",
+	"fAPHoxUfrfAa4TxHg83Jh5uTTzbP/2rz/Meb5z/aPP+J/vPkb4ehQy8ZPSZC4jwwat9AoXH6xMm3O4Jk",
+	"UI7cP78c2Vp53ARPtaLQYQEj6SgYzqp6lPCci5C+myzmQqsvCFrUdlqatGjwN+7cubN/exICVDULKfh7",
+	"NECR9c8aQ4woQGstxPVszPT/fvBnvzx1mh7KcbNhQoatWPg1htfHfvKLzckfb04+CU3TGLSjxWRcKN96",
+	"3UYDBZoWqNgkrezfwXlaqN8VOgwF7B10jB5wNnImaGucrvW96kOFVdV4SywIUxmRRDb5jdleyVIiZEaF",
+	"QoPf/OCn//f5f/rNX34veNVIRSLOkptqGmB8aVNeqvqD1c0GK6oZNpqMd/V9GtbkZUrQigvmrHIg7SRY",
+	"4ZzPjcSzrWzjEbWAfAMLPGuIe9AIru420tDDShqyNLIHVJ5nDM67zEGrAvMTlQCiXrrqyG+KpiTBpWwo",
+	"CuCjI9oMqQVHhcWcKAvxMXrDmOes6c4h8gobE5Ubtoklm5Ofwg36MyCl/xiUTjK+BMyWwTvU8al4t2hr",
+	"a0DGlw9wQQ4VVqUMnWaXEXQMnP7F8G+y9d8lvJjSUYZTPKfDFhX5BcDg++Z2vBL+gQYTcw7WRjpsMpSL",
+	"l149R/HOqUNgO4Q9bvOT6gp4pg+fJPQxqpucpTRMYK/PjWHCF/jANg7GAWMg5MxcDyRIwouCMG1JYA55",
+	"ujYD6H/mNYVGGnxlTo5o2pQbMqxGCc/Ts4TM80zVOio3b+ytOTR4H1BvVbrAWTLASzL1c3DoBVk3e4Ch",
+	"cjumvj2nThwayZDpzKdnVNa4QtID+ID7MY3PjG4NSEYZskcjKyUbKC/wHWPUsv2wIEA2CyLmJI2R5A4v",
+	"/ekR1yaxPIXZM660F0iigfNmf/YPn3720z8dogxLpFYcSbLEAmw/nYWey2ZbX7oAqfxa6Nle6DmnkGBx",
+	"J+Dxrjl8hR0VWMfodrFU1mLGeIfe0aal4N2IkZUOxBm5m9b4e5RxpQlHhSxdIta29JzCwB+0WTbCyvpP",
+	"dTe4Jfo/Tvw+n4Tm+Pp2HL0+xurQfhe8ePfVs+IG8Xw5ZuxQrUEaW0z+DB3yHpUNh0SPw2prqmO5Uucs",
+	"gx6UvkU9EpjJHDvqdA7+dvvW7St3Lp+fv/34f52fWf0QzAr//LVa+eWqlQ3qA4y4SYKM/elLJkTkCU4C",
+	"ZrE7OJek1qXAp2+9xqnxgOCZIsKGidAPwDi9JHmu/3OMBcV6y9V0U85zgoFh20F6jlXLILKjVhne4qav",
+	"FtTS5f58c/J3p9rB3NRARqxk19Xo0Eqrr2be06dkVGa0wEqWa3xu+93zv92c/Cnonb0rflm6uz2h7UCk",
+	"dT4OP87Whu45WaJlynKeC5A1Gg5/SVPQ7A+s838A10p7iIcxKmia5gQNwPNBpLGZQNAjVUPwBuke9a+2",
+	"nb4oSV6mgI45IUskcbKQQ2OqSRIipbarDTKspJZqk4WM0Xg8BqbIygIouJU7zBKMaRl+qPprANQnWbXr",
+	"nH/lSwsgGrhcWGpNG1Lfes4IWnLKIF5H0WJ773htI9EhP3VssrYcDqynO2SDd2P2xIT1+diN8prS2YwI",
+	"aY/UG6F2kPZ64msfqfWStRUYMPLraPQcU9bYH1YIo3mOWfJqHeuDTrx2HQI/RM54VYXVNaSokP/bLgUC",
+	"7MPWAW0G0F9Pn7rleHaHonvmXpgfMJK5wKzMsaBq/U3ToMG+9r6UIIFBf6B7F2xjdPv9EucSNSarlAPX",
+	"s8IPF89RxehrrRTi9AkW+drGrimuMZ5ba6F0dwuY4/jMk/rSwgcCu/+CQQRXxlf/pYMItFytcc23AxhA",
+	"o0MbQ1CHJYViDa71uKjRwLqo7U0fjr+82ANW2ZSXRHwdefBvOfLg0mR0+RVGHoDT4eiM4CagqVr1BEFE",
+	"8RYZiOKXTHb6OvLh1UQ+bB/y4J/3v94AiEoQ7TeI1IJEP8mv22hKAJhuSOUAWL8RWhRHexedbDBdo9TE",
+	"2W8dSFat9UxTi7fk0Kbf0OJpQPLS5D4RxGTipGWiGs7ZKS81nwcpU2tFHRHzPRwwe3z7+gM0uH39wRCC",
+	"wBuYfXEyubx/dXd/f/+1/d0erZQIiltWmN/88p93J5N/H+qwFPTsS/CmblS5giB/MayFaAGuGMINdfCg",
+	"Eklt1qcNFvLupUl8eRKwvXqiVIfZLcqwkn1BVrNZqSWkP4sAcXnTdlviOUFvPbx3ttos8sgBzUEiiC9V",
+	"bHs7n8tzeEAbYHKckToe+LYXwAj+Dhcx7F3bAytRQBYSlrIsjOQ6haQ9zWyBdfLc/Lw3Ge1d/PWHJ04A",
+	"gQyfPg0wBg5oNN5S2iDmKUkFb+lmWCGTpjPYverGn/E85ytqpVmtTpsmOV8NAypWHcYfkqzAZuV0tQKn",
+	"RNMAqYP59QRGKtTBEVVoAJJEXZDIDzRHCk9zEqMpkQrNqJAKDWZkpf/SI0lIOzWiWk6wdDEAVpBY6dsM",
+	"fjKDA8NAMgF2aioQszpKoXZfOD3LHaqT5Stnm55F8sKYq5AEscTKjxVh0vuiHxAn7XigQ0L75YiLYjgy",
+	"O6jC1w0ALxgcsrurNGqHhFhPKcEi6VgsDDZG122C9/7WInQ3xyNwmy0sgj5KQTw9jWAhm2kRtXFFo65h",
+	"V9TkjoJY3bCoVI06FKFHJ3nY1kXs8emkZCfb96eagM0Rro6FLE61H94zIPk5dBhNc8wWRNUH4i4aTMfz",
+	"L6y3nEuscofSkKZ+9qPP/vAPgtKUj269XqSBjVmqcE1mvMxTg7Ttq3FBdlMZMUsrdLgAl8amLFpcpnUg",
+	"DyJazTfWZBOl0yIUNl5I39RYH413AlwgfMxpiubc6pdNbX985cpW6vLLS9WvJlPnyvmdcPWxB4RGTyys",
+	"wlmaJx836XiIH3oSRIAJ08T67j2Lq8JPOuwiKYUgLGlFUXz7zXfCotCTRrvdvdeCFpuCska710LNOtIi",
+	"i8wMcb2o8L5N+lxXKxaCC+TSEB2xf3jnJrpydXIF2WQ9ZBQDIEEFVmjQl9A37MKKp2TL5L6buik445z2",
+	"2jJXlQVmI0FwqlmpMaQyXDvkqEQ8sWAgaHCbzXMqsxjMaxLYbzNKzCsHoruWxgSkz//ALxVS2NojU6JW",
+	"hDC0N9mbaOV29yqQhL3J3pXR5Opoby+ojpv4t+5eHj16E5mPHfH64iSIIIqqnJw+Uodo3sApcjmlcV9c",
+	"QUebMAlljyOIAz0AwvQ4ugaymskH5WCyQEQjjzQGisa0XsczRVn46nZXASw2iHMKLt/kaWDth8rgBqC1",
+	"HmKMqElwPaoM9QcIN632BZUSaK1ABc41juvEvJUAY+h6SeIqL1qzfHSM89L7zTgzc1pQ1UCi4RjVVWKO",
+	"eKmO+OzIR68jh3qFKc6CWauuDRfep2ZhmzFyhVVaI7uf9bglEwTnVCqaAIOrZx2jUFfbzVaL8dbIRXiN",
+	"wOCoqmFcSYluTC2hGMtDjBQ3/9PDSUWW7VvnDVNnch/UxuS4NiXHSJInsKy6AkZrx/VoDX2lnQBfVoKm",
+	"K03iFmPP98h4iA5cQYuUE2OxB6ciwmztvnDhFZvQ/WW5XHKhTSTOfX8AjnyXY45R1cJz8Dczlw8Q41Uw",
+	"dTPP+eLkIugytRzaQvQojnowMIqjIP5EcRT6red4/S/1iXm/Ntl3E6DwQxdCls3XAIi+06EgcdTDwr+F",
+	"89mILwmzbPzdgrIYFfjJcIyuV8UxtD2EpQZd4L8mhLplO8KBQh23nyR5KbWqUy6XRJiBGt6oyVaymeX1",
+	"7UR8N3jOV6HBd7cMMe5QzIeVeb6t4qybUeWe/yPohtzGxlrZZwoiJZ6Tc/FxK3+k7QpFkKir/2py8M8/",
+	"/ui3n/5wb3Pyy88//ujFJx9dNP/7/OOffP5P33vxyR9tvvfs8z/5689+9t8///s///wf/mZ3b7z/6w9P",
+	"Xnzy0e6lX394Ujd79rPNsx9snj8L8UiI29JpOMvAkd1yxEO3QtDKRaeDShYbnWxYt9EqgjZ8qIa4HLkE",
+	"rPD0ND11bndqBoYwjR9c0pjIRR72xkufrkwdEtXyhdsAWpvhgFGuSbTRbc6Z73Dg6ftWXbMdrOtfcaOt",
+	"2bDeMnfOfrMSzuy+vUhMkApdCJLR3hh4gCSRyhU68GuonL/cQEfVamlN9UcTt1Djgt4a0dIEWMq0Yti4",
+	"7nvj/fNOf74b2uwoaTBSG8IioFaO1xyWbuoXgJ78TdtE/xGjgupfCi4YZfMdrQtDtAxf+SEpumlkSGEz",
+	"9MT8frrQWIfje9czrmnUaR4Ms9OgQ8KRrJDYeUiwSLJ+Z4OLGiPpkYmqCrrS4FMrzgyubTh/ZXPynzcn",
+	"39s8/1noqlbTeB2ffX/z/PubZ7/YPPv55tn/2Dz7k1BHQaSuErR1qGi19TJXZ3owqlI5bXjU854KXj3H",
+	"VyJN4iVyHr4OI90+jHSLwMWmPVI6kXsFZZ26EZWnzRK28eobqXvGSBCcWhWwWeywGaY5NYr0eS+cTLgI",
+	"BswV1EQ2VcYF6/ZHg13wqDAEsYpm/obss7sVa/jXlyhwZurelx9Aak6rhTsNWPYQsKCQfdio7GR0bHct",
+	"jWIpl3pnaHAfi9F9vB7GSJZFoV3Q3y7Z6Ho518UOS1UWDA0OyXL0gB+b4M8VZRAyeoskoztkOowReaJ1",
+	"WJeY6qa4IG1FO4EpW7voxMHnP/yD3/7FX1svgpbGDMlGUmEBshFhKSxYDsH3pDsfmc5j9C1b0hE8g4w3",
+	"hvaVUrO5KI787hqasMUojszOtFUXdhNU916uaqIvbGC4HzMC/wlO4fC1w3v6qyBsTv5qc/IfN89/vjn5",
+	"aW+ebSfbrmT0/Zz3tg9N8183J38DBRH+8SVCrL046L780jBCN1N4g8RTk4IL0jAHG+2rBx2j20xB4Smv",
+	"HCuQD01DDY+RzmNOuaaAAafsMaa51hCDvjnwyaqs4aqshf0ep6VPQE2p5m4s/1ToSJS+kgwCV9UY+Kye",
+	"f/CNhw9ff/3GjWGM6AxunVR6s+0iDZPJZHJ+hu4K9jbC4Uwcx/bImPM5PwpGH7z18F5jM7pldx+dATmE",
+	"Sgf8KKVIMixBt7fxELJyNcME/S5lP6uOEcCeehFG7ZuWNFcjr8KAzOgSSJCbDFI7zWwQekdg1FLHr5pN",
+	"EnFsDL9A6azqhXA1oi5liwY3rz+6fu+N14/u3L132xY70JZFUDiLrf2hJmInwAQd4vcfN1yYJprJAuc5",
+	"RHdq3g1hBMO2/anrOegXsd5i9P3SVM91HtAOYm1Jr7rCRVu2qBCgq+ucbE4+ArbvU7rzCQIemavX1SF5",
+	"FdRjj75UuBwkg8Fo0puNGFIQSI3Jkc9q6Rm+uZLZSaGNkK2PEFEyxVMglVB14//870tJgbAp+2JKm05J",
+	"DigNPa5OTKcrl0ZXLyXFOOBwY1ogconXZwWA3vSa97vpb2LGGSQY1HvqRqT2uD+Dsef3sJgTqULAquQj",
+	"AKgGXCNevM97GpzmEC7My8xz6Wz3qwODnbzabNw4hD6kutk8qJaluUpNsnX6KXO5c3r9ci018YmRdPsD",
+	"WtBBBhIIVbv9lh1yMOXpulExf9jMWr6kT/SSTtC8vHe+BOWQvPDWoYmksZNbH9IOUlznGonW3A9uRHE0",
+	"Ge3fj+Jof3T5/nnmb6sIUg9dhg/Cr1oaiKuURJlimaSQtZVT8bkRQKbrqqaW37JzDlrEbgh17wYUnYBs",
+	"dy6gK96e4uTjzcl/2Dz/n6YUDIyp45HbJWJeHrSwL5g5BNwtCoartnV0O0eM6QxFuwcpXitaNH37e3tb",
+	"PZEQG2vjKcXMX5Vz51ZVbXzQsosOzz1F6ww8QypQoLOO4oY1EbfCzXSMsLGOB2Lba1Pv0Ephmj9hEPG1",
+	"LFJHonnhj20Dq3lLIqeMoHJpZC7Nyay/QhCZ8TyV1+pQShtmXVVbImvzSImO4x0YJ8LuRCONfjCA5vka",
+	"DXRcxiX7E+c5GuxeGu3ZNgXV/XQMKrSoKnwM4G9X+eqYNJyq1k9ixtf/4dyQfPjZVm7oXJpuuP4p8YU+",
+	"oBNsgTn2khZtRqP7oS+J8RqSrlR/KAmycgv726smieKo6n3WlsIV6R/5+MJ0/VVbgh59E5mYXJwJwjJC",
+	"FfzyR743wDbVOnrVKryMutrAKYH/xkXeXeN9/aGKxYt9q6KNyeWMIMKUWEMWVW3/1B3OVTjWW+jZUf92",
+	"vd2bq1tSNuMB3vTmXUCEytmlt1WFqqIplqaYjRfJ1MpHjc1N6xpS5bgKyDFV99Hbeki3Iy7Q9TfvRnFk",
+	"xZfoINodT8YTUAqXhOEljQ6i/fFkvB/F0RLbzMudCnRzEgxJV6Vg0logm4B3kppT0Cra4Wx38VlFg6hq",
+	"lRkyD1KYjMragFGbPzUIOIxCObub2oyRu467ew9bvRvGhbrJDjzD8/Q7rXL/e5PJKWXyz1cev1McJFAn",
+	"/3qeNwJJZLdM39NYp3b0TVatfsd7qeDpU2PLw2JtYdScBBqYo9+R4OPpxYA75QcfgAlR6DJix43rZ18m",
+	"smZ6GYewNvRU0RjdKfN8tKKpynYyHR4C/0VJhgVO9OnEKKMCzzHDOwus8AIzHCPtox8d8xXJUYHFwgwN",
+	"srZ+HQbbWlLOweUipgpLXUBbexzwDTyOEBfocfTiV3/x4ld/+eJXf/ziVz948enfv/j008cRmlGr8Qkj",
+	"kEOdIeMQM3Y0oQOEwX5GUqq0WV5hlhC0g6Z0LnCBZOVP6GKv8a/14G/HYq1PwGSBXEc6NC4nowpi1ocJ",
+	"UQDCXlovtBkcFvnaGEz0ufQ9ovH+li+nbeNieRp3Kf0TWpSFzcQxyb0Ayp7VQPxe4zkPK31EB7sTkKv0",
+	"cEYtLCgzf+yGVMSvADlo+aoDxOAsRvjFSYHFog4RUJaLkF46cI/zhdQiIjzF02QEM3jTqXn5q6Qr/3mn",
+	"VukU/RyUkZQcwtbMo4rsr+uBOIJivCh10Ro5Rr9vicSOIxvQ3SMYrlIM3FnnOKyX5VQyp4/ph9FY2vjk",
+	"aWv6carOXXaMmNy1RRVPvc0NWFXPvEHsS3UU4TsB/2x5Sc+qVPM0Di0MLI/KemGdVROkX82tAOIARsMU",
+	"tn3kypgYQ8s8vbrNV+LuhgTc0y5w84LIl7q4usvFs7tUr/s0b3qFjp2bWWGbTwC+23TZPvXoQBPNXydq",
+	"GwSvC8UOnJFv6M1scUSLoTWKdLzG2+D4KTXsvhKY41d06yLMI09+pjZJ63eMKK8ThXBrERovmnnap2oH",
+	"GHww7VodtdpTZ8pBWpdXpeRGlbZd/2pptH0erpXwjdaQ8sfz1KQ+hELZYxPIrgm4V6fGq93irIPWxqyZ",
+	"GkeX66ED1P11ou7XADmvrlE/srsFTtrnHrdo6d6j3aJp9UbrFm2rx962aAvvEZ7dzHuMc5tBQ48bbtGv",
+	"83Jd3FMNyb06ZGxqxsjgYaX/Yt+kj6HVmBdma758emXPk08nYfl0i4Xm2F8nGlAXnN56K3Pvon2cGWoY",
+	"EZQTKU2aSL3ovm0pftqm9i5+oV3dZYqIY5x7W3MxVu6+12SguafdXsFCkR7BYvdspaC5PLeGgSslUUmm",
+	"2AO7NUcosrR7GLsaWf2tdQPr7N6vDjVPYyM6GedLkpkHLbFURCpjBulCxYapakVO64Ht6lzeWWMzq/fM",
+	"NCNPVDUkYJCroAwtd/etlmu7QEu0D3FGOZkpl/YaOgWP0Ib1NQdcP/6n+sXsImBa/Eqw8G5RkwAnPyyh",
+	"aN+szP3DElWPL6q5aTZdc9R6CsOseVVS4lRGvcqqgPvp2uVat5/KO6hqm8WNKgCt57NqO6WzAzmNLVww",
+	"0M8ZmHEBBdWk9yiDrpXnanp5RCDEid9wyf5fhAsHXoT238U98/Xn6vl7R8Ga5fQAGoGsvL4X2U99jX3r",
+	"J+q+Fi9+x+LFvzhxCr2K2aNoeBe5etnxldClFpXA1jppZ4D2EN0VUhSh1oSN/opsJZ4oU2p5sLOT628Z",
+	"l+rg6uTqJHr6naf/fwDYj8zhzIIAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+// ListItems は GET /items エンドポイントを処理します
+func (h *RecommendHandler) ListItems(c *gin.Context, params ListItemsParams) {
+	lang, err := h.language(c, params.Lang)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	catalog := h.catalog()
+	items := make([]ItemDetail, 0, len(catalog.Items))
+	for _, item := range catalog.Items {
+		items = append(items, h.newItemDetail(catalog, item, lang))
+	}
+	c.JSON(http.StatusOK, ItemListResponse{Items: items})
+}
+
+// GetItem は GET /items/{universal_name} エンドポイントを処理します
+func (h *RecommendHandler) GetItem(c *gin.Context, universalName string, params GetItemParams) {
+	lang, err := h.language(c, params.Lang)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	catalog := h.catalog()
+	item, ok := catalog.Item(universalName)
+	if !ok {
+		problem(c, http.StatusNotFound, ItemNotFound, fmt.Sprintf("unknown item: %q", universalName))
+		return
+	}
+	c.JSON(http.StatusOK, h.newItemDetail(catalog, item, lang))
+}
+
+// newItemDetail はカタログのアイテムと、それを推薦するルールの条件をレスポンスの形式に変換します
+func (h *RecommendHandler) newItemDetail(catalog *domain.Catalog, item domain.CatalogItem, lang domain.Language) ItemDetail {
+	cat := categoryOf(catalog, item.Name)
+	detail := ItemDetail{
+		UniversalName: item.Name,
+		DisplayName:   item.DisplayName(lang),
+		Description:   optionalString(item.Description(lang)),
+		CategoryKey:   item.Category,
+		CategoryLabel: cat.LocalLabel(lang),
+		CategoryEmoji: cat.Emoji,
+		CategoryColor: cat.Color,
+		Layer:         optionalLayer(item.Layer),
+		Warmth:        item.Warmth,
+		RuleIds:       []string{},
+		Conditions:    []ItemCondition{},
+		ShopNames:     newEquivalents(catalog, item, ""),
+	}
+	for _, r := range h.rules.RulesRecommending(item.Name) {
+		detail.RuleIds = append(detail.RuleIds, r.ID)
+		detail.Conditions = append(detail.Conditions, ItemCondition{
+			RuleId:           r.ID,
+			AgeRange:         newRange(r.Age),
+			TemperatureRange: newRange(r.Temperature),
+		})
+	}
+	return detail
+}
+
+// TranslateItem は GET /items/translate エンドポイントを処理します
+func (h *RecommendHandler) TranslateItem(c *gin.Context, params TranslateItemParams) {
+	lang, err := h.language(c, params.Lang)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	catalog := h.catalog()
+
+	shop := ""
+	if params.Shop != nil && *params.Shop != "" {
+		if _, ok := catalog.Shop(*params.Shop); !ok {
+			problem(c, http.StatusBadRequest, InvalidParameter, fmt.Sprintf("unknown shop: %q", *params.Shop))
+			return
+		}
+		shop = *params.Shop
+	}
+
+	translations := catalog.Translate(shop, params.Name)
+	if len(translations) == 0 {
+		problem(c, http.StatusNotFound, ItemNotFound, fmt.Sprintf("no item matches %q", params.Name))
+		return
+	}
+
+	matches := make([]ItemTranslation, 0, len(translations))
+	for _, t := range translations {
+		cat := categoryOf(catalog, t.Item.Name)
+		matches = append(matches, ItemTranslation{
+			UniversalName:  t.Item.Name,
+			DisplayName:    t.Item.DisplayName(lang),
+			Description:    optionalString(t.Item.Description(lang)),
+			CategoryLabel:  cat.LocalLabel(lang),
+			CategoryEmoji:  cat.Emoji,
+			CategoryColor:  cat.Color,
+			MatchedShopKey: t.Shop.Key,
+			MatchedName:    t.Name,
+			Exact:          t.Exact,
+			Equivalents:    newEquivalents(catalog, t.Item, t.Shop.Key),
+		})
+	}
+
+	c.JSON(http.StatusOK, TranslationResponse{Matches: matches})
+}
+
+// SearchItems は GET /items/search エンドポイントを処理します
+func (h *RecommendHandler) SearchItems(c *gin.Context, params SearchItemsParams) {
+	limit := defaultSearchLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxSearchLimit {
+			problem(c, http.StatusBadRequest, InvalidParameter, fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	lang, err := h.language(c, params.Lang)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	catalog := h.catalog()
+	found := catalog.Search(params.Q, limit)
+
+	results := make([]SearchResult, 0, len(found))
+	for _, r := range found {
+		cat := categoryOf(catalog, r.Item.Name)
+		results = append(results, SearchResult{
+			UniversalName:  r.Item.Name,
+			DisplayName:    r.Item.DisplayName(lang),
+			Description:    optionalString(r.Item.Description(lang)),
+			CategoryLabel:  cat.LocalLabel(lang),
+			CategoryEmoji:  cat.Emoji,
+			CategoryColor:  cat.Color,
+			Score:          r.Score,
+			MatchedText:    r.MatchedText,
+			MatchedShopKey: optionalString(r.MatchedShop),
+			ShopNames:      newEquivalents(catalog, r.Item, ""),
+		})
+	}
+
+	c.JSON(http.StatusOK, SearchResponse{
+		Query:           params.Q,
+		NormalizedQuery: domain.NormalizeName(params.Q),
+		Results:         results,
+	})
+}
+
+// 検索結果の件数の既定値と上限です
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+// newEquivalents は matchedShop 以外のショップでの名前を、ショップの表示順で返します（空文字なら全ショップ）
+func newEquivalents(catalog *domain.Catalog, item domain.CatalogItem, matchedShop string) []ShopName {
+	names := make([]ShopName, 0, len(catalog.Shops))
+	for _, shop := range catalog.Shops {
+		if shop.Key == matchedShop {
+			continue
+		}
+		names = append(names, ShopName{
+			ShopKey:     shop.Key,
+			DisplayName: shop.DisplayName,
+			ShopName:    item.ShopNames[shop.Key],
+		})
+	}
+	return names
+}
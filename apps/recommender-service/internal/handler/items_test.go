@@ -0,0 +1,222 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+	"github.com/kenji/baby-wear-translator/backend/internal/handler"
+)
+
+func TestTranslateItem_OK(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/translate?shop=nishimatsuya&name="+url.QueryEscape("プレオール"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.TranslationResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(resp.Matches) != 1 {
+		t.Fatalf("len(matches) = %d, want 1", len(resp.Matches))
+	}
+
+	m := resp.Matches[0]
+	if m.UniversalName != "カバーオール" || m.CategoryLabel != "ミドル" || !m.Exact {
+		t.Errorf("match = %+v, want カバーオール (ミドル, exact)", m)
+	}
+
+	want := map[string]string{"uniqlo": "フライスカバーオール", "akachan_honpo": "ドレスオール"}
+	if len(m.Equivalents) != len(want) {
+		t.Fatalf("equivalents = %+v, want %v", m.Equivalents, want)
+	}
+	for _, eq := range m.Equivalents {
+		if want[eq.ShopKey] != eq.ShopName {
+			t.Errorf("equivalent at %s = %q, want %q", eq.ShopKey, eq.ShopName, want[eq.ShopKey])
+		}
+	}
+}
+
+func TestTranslateItem_OK_FuzzyKatakana(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/translate?name="+url.QueryEscape("ボディースーツ"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.TranslationResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(resp.Matches) == 0 || resp.Matches[0].UniversalName != "ボディースーツ" {
+		t.Fatalf("matches = %+v, want ボディースーツ", resp.Matches)
+	}
+	if resp.Matches[0].MatchedName != "ボディスーツ" || resp.Matches[0].Exact {
+		t.Errorf("match = %+v, want fuzzy match on ボディスーツ", resp.Matches[0])
+	}
+}
+
+func TestTranslateItem_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "name がない", query: "", want: http.StatusBadRequest},
+		{name: "未知のショップ", query: "shop=muji&name=" + url.QueryEscape("プレオール"), want: http.StatusBadRequest},
+		{name: "一致なし", query: "name=" + url.QueryEscape("宇宙服"), want: http.StatusNotFound},
+	}
+
+	r := setupRouter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(t, r, "/items/translate?"+tt.query)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d; body = %s", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestSearchItems_OK(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/search?q="+url.QueryEscape("こんびはだぎ"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.SearchResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if resp.NormalizedQuery != "コンビハダギ" {
+		t.Errorf("normalized_query = %q, want コンビハダギ", resp.NormalizedQuery)
+	}
+	if len(resp.Results) == 0 || resp.Results[0].UniversalName != "コンビ肌着" {
+		t.Fatalf("results = %+v, want コンビ肌着 first", resp.Results)
+	}
+	if resp.Results[0].Score != 1 || len(resp.Results[0].ShopNames) != 3 {
+		t.Errorf("results[0] = %+v, want an exact match with all shop names", resp.Results[0])
+	}
+}
+
+func TestSearchItems_BadRequest(t *testing.T) {
+	r := setupRouter()
+	for _, query := range []string{"", "q=a&limit=0", "q=a&limit=51"} {
+		w := doRequest(t, r, "/items/search?"+query)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want %d", query, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestTranslateItem_OK_Language(t *testing.T) {
+	r := setupRouter()
+	req, err := http.NewRequest(http.MethodGet, "/items/translate?name="+url.QueryEscape("プレオール"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept-Language", "ko-KR,ko;q=0.9")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if got := w.Header().Get("Content-Language"); got != "ko" {
+		t.Errorf("Content-Language = %q, want ko", got)
+	}
+
+	var resp handler.TranslationResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got := resp.Matches[0].DisplayName; got != "우주복 (カバーオール)" {
+		t.Errorf("display_name = %q, want %q", got, "우주복 (カバーオール)")
+	}
+	if got := resp.Matches[0].CategoryLabel; got != "미들" {
+		t.Errorf("category_label = %q, want 미들", got)
+	}
+}
+
+func TestListItems_OK(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.ItemListResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(resp.Items) != len(domain.DefaultCatalog().Items) {
+		t.Errorf("len(items) = %d, want %d", len(resp.Items), len(domain.DefaultCatalog().Items))
+	}
+	for _, item := range resp.Items {
+		if len(item.ShopNames) != 3 || item.CategoryEmoji == "" || item.Description == nil {
+			t.Errorf("item %q is missing details: %+v", item.UniversalName, item)
+		}
+	}
+}
+
+func TestGetItem_OK(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/"+url.PathEscape("短肌着")+"?lang=en")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var item handler.ItemDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &item); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if item.DisplayName != "Short undershirt (短肌着)" || item.CategoryKey != "inner" {
+		t.Errorf("item = %+v, want 短肌着 in English", item)
+	}
+	if len(item.RuleIds) == 0 || len(item.Conditions) != len(item.RuleIds) {
+		t.Fatalf("rule_ids = %v, conditions = %+v; want one condition per rule", item.RuleIds, item.Conditions)
+	}
+	for _, cond := range item.Conditions {
+		if cond.AgeRange.Min != nil || cond.AgeRange.Max == nil || *cond.AgeRange.Max != 4 {
+			t.Errorf("%s: age_range = %+v, want [, 4)", cond.RuleId, cond.AgeRange)
+		}
+	}
+}
+
+// TestGetItem_SeparateConditions は寒い日と暑い日に推薦される帽子の気温の範囲が、
+// 1つにまとめられず（推薦されない 10〜25℃ を含まず）ルールごとに返ることを確認します。
+func TestGetItem_SeparateConditions(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/"+url.PathEscape("帽子"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var item handler.ItemDetail
+	if err := json.Unmarshal(w.Body.Bytes(), &item); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if len(item.Conditions) != 2 {
+		t.Fatalf("conditions = %+v, want hat-cold and hat-sun", item.Conditions)
+	}
+	cold, sun := item.Conditions[0], item.Conditions[1]
+	if cold.RuleId != "hat-cold" || cold.TemperatureRange.Min != nil || cold.TemperatureRange.Max == nil || *cold.TemperatureRange.Max != 10 {
+		t.Errorf("conditions[0] = %s %+v, want hat-cold below 10℃", cold.RuleId, cold.TemperatureRange)
+	}
+	if sun.RuleId != "hat-sun" || sun.TemperatureRange.Min == nil || *sun.TemperatureRange.Min != 25 || sun.TemperatureRange.Max != nil {
+		t.Errorf("conditions[1] = %s %+v, want hat-sun from 25℃", sun.RuleId, sun.TemperatureRange)
+	}
+}
+
+func TestGetItem_NotFound(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/items/"+url.PathEscape("宇宙服"))
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
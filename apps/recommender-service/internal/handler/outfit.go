@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetOutfit は GET /outfit エンドポイントを処理します（指定した日、省略時は今日のコーディネート）
+func (h *RecommendHandler) GetOutfit(c *gin.Context, params GetOutfitParams) {
+	p, err := h.profile(c, profileParams{
+		BirthDate:       params.BirthDate,
+		Region:          params.Region,
+		DueDate:         params.DueDate,
+		HeightCm:        params.HeightCm,
+		WeightKg:        params.WeightKg,
+		Sex:             params.Sex,
+		MeasuredOn:      params.MeasuredOn,
+		Temperature:     params.Temperature,
+		TemperatureUnit: params.TemperatureUnit,
+		Lang:            params.Lang,
+	})
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	date := h.today()
+	if params.Date != nil {
+		date = params.Date.Time
+	}
+	if err := domain.ValidateTargetDate(p.birthDate, date); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	// その日の身長を推定するため、小数の月齢を使う
+	day := h.buildDay(h.catalog(), p, date, true)
+
+	c.JSON(http.StatusOK, DailyOutfitResponse{
+		Date:                 openapi_types.Date{Time: date},
+		AgeInMonths:          day.age.Chronological,
+		CorrectedAgeInMonths: day.age.Corrected,
+		Size:                 day.size.String(),
+		SizeDetail:           newSize(day.size),
+		EstimatedHeightCm:    day.heightCM,
+		Temperature:          newTemperature(day.temperature),
+		TemperatureSource:    day.source,
+		EstimatedTemperature: newTemperature(day.estimatedTemp),
+		Season:               Season(day.season),
+		SeasonLabel:          day.season.Label(p.lang),
+		TemperatureBand:      TemperatureBand(day.band),
+		TemperatureBandLabel: day.band.Label(p.lang),
+		Items:                day.items,
+		Outfits:              day.outfits,
+	})
+}
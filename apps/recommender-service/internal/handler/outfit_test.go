@@ -0,0 +1,146 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+	"github.com/kenji/baby-wear-translator/backend/internal/handler"
+)
+
+func TestGetOutfit_OK_SameAsMilestone(t *testing.T) {
+	r := setupRouter()
+
+	w := doRequest(t, r, "/outfit?birth_date=2025-10-01&date=2026-01-01&region=hokkaido")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp handler.DailyOutfitResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v; body = %s", err, w.Body.String())
+	}
+
+	w = doRequest(t, r, "/milestones?birth_date=2025-10-01&region=hokkaido")
+	var milestones handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &milestones); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	m3 := milestones.Milestones[3]
+
+	// 同じ日付のマイルストーンと同じ結果になる
+	if resp.AgeInMonths != 3 || resp.CorrectedAgeInMonths != 3 {
+		t.Errorf("age = %d (corrected %d), want 3", resp.AgeInMonths, resp.CorrectedAgeInMonths)
+	}
+	if resp.Size != m3.Size || !reflect.DeepEqual(resp.SizeDetail, m3.SizeDetail) {
+		t.Errorf("size = %q %+v, want %q %+v", resp.Size, resp.SizeDetail, m3.Size, m3.SizeDetail)
+	}
+	if !reflect.DeepEqual(resp.Items, m3.Items) {
+		t.Errorf("items differ from milestone[3]: %+v", resp.Items)
+	}
+	if !reflect.DeepEqual(resp.Outfits, m3.Outfits) {
+		t.Errorf("outfits differ from milestone[3]: %+v", resp.Outfits)
+	}
+	if len(resp.Items) == 0 || len(resp.Items[0].ShopNames) == 0 {
+		t.Errorf("items should have shop names: %+v", resp.Items)
+	}
+
+	// 使った気温は日付と地域から推定した外気温
+	want := domain.ClimatologyProvider{}.EstimateTemperature(domain.RegionHokkaido, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if resp.Temperature.Mean != want.Mean || resp.Temperature.Min != want.Min || resp.Temperature.Max != want.Max {
+		t.Errorf("temperature = %+v, want %+v", resp.Temperature, want)
+	}
+}
+
+func TestGetOutfit_OK_DefaultsToToday(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	now := func() time.Time { return time.Date(2026, 2, 14, 9, 30, 0, 0, time.Local) }
+	h := handler.NewRecommendHandler(
+		handler.WithTemperatureProvider(fixedTemperature{temp: 3}),
+		handler.WithClock(now),
+	)
+	handler.Register(r, h)
+
+	w := doRequest(t, r, "/outfit?birth_date=2025-10-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp handler.DailyOutfitResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	if got := resp.Date.Format(time.DateOnly); got != "2026-02-14" {
+		t.Errorf("date = %s, want 2026-02-14", got)
+	}
+	if resp.AgeInMonths != 4 {
+		t.Errorf("age_in_months = %d, want 4", resp.AgeInMonths)
+	}
+	if resp.Temperature.Mean != 3 {
+		t.Errorf("temperature.mean = %v, want 3", resp.Temperature.Mean)
+	}
+	if len(resp.Outfits) != len(domain.Contexts) {
+		t.Errorf("len(outfits) = %d, want %d", len(resp.Outfits), len(domain.Contexts))
+	}
+}
+
+func TestGetOutfit_OK_EstimatedHeight(t *testing.T) {
+	r := setupRouter()
+
+	// 測定日から数日しか経っていなくても、その日の身長を推定する
+	w := doRequest(t, r, "/outfit?birth_date=2025-10-01&date=2025-11-10&height_cm=55&sex=female&measured_on=2025-11-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp handler.DailyOutfitResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if resp.EstimatedHeightCm == nil || *resp.EstimatedHeightCm <= 55 {
+		t.Errorf("estimated_height_cm = %v, want > 55", resp.EstimatedHeightCm)
+	}
+}
+
+func TestGetOutfit_OK_SpecifiedTemperature(t *testing.T) {
+	r := setupRouter()
+
+	w := doRequest(t, r, "/outfit?birth_date=2025-10-01&date=2026-06-01&temperature=50&temperature_unit=fahrenheit")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp handler.DailyOutfitResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if resp.Temperature.Mean != 10 || resp.TemperatureSource != handler.Specified {
+		t.Errorf("temperature = %+v (%s), want mean 10 (specified)", resp.Temperature, resp.TemperatureSource)
+	}
+	if resp.Temperature.Min >= 10 || resp.Temperature.Max <= 10 {
+		t.Errorf("temperature = %+v, want min < 10 < max", resp.Temperature)
+	}
+}
+
+func TestGetOutfit_BadRequest(t *testing.T) {
+	r := setupRouter()
+
+	for _, query := range []string{
+		"",
+		"birth_date=2025-10-01&date=2025-09-30",
+		"birth_date=2025-10-01&date=2031-10-02",
+		"birth_date=2025-10-01&date=2026-01-01&region=atlantis",
+		"birth_date=2025-10-01&date=2026-01-01&due_date=2026-10-01",
+		"birth_date=2025-10-01&date=2026-01-01&height_cm=5",
+		"birth_date=2025-10-01&date=2026-01-01&temperature=-60",
+	} {
+		t.Run(query, func(t *testing.T) {
+			w := doRequest(t, r, "/outfit?"+query)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
+)
+
+// problemContentType は RFC 7807 のエラーレスポンスの Content-Type です
+const problemContentType = "application/problem+json"
+
+// problemCodes はドメインのエラーと、それを返すときのエラーコードの対応です
+var problemCodes = []struct {
+	err  error
+	code ProblemCode
+}{
+	{err: domain.ErrBirthDateOutOfRange, code: BirthDateOutOfRange},
+	{err: domain.ErrDueDateOutOfRange, code: DueDateOutOfRange},
+	{err: domain.ErrDateOutOfRange, code: DateOutOfRange},
+	{err: domain.ErrInvalidMilestoneRange, code: InvalidMilestoneRange},
+	{err: domain.ErrInvalidMeasurement, code: InvalidMeasurement},
+	{err: domain.ErrInvalidTemperature, code: InvalidTemperature},
+	{err: domain.ErrUnknownRegion, code: UnknownRegion},
+	{err: domain.ErrUnsupportedLanguage, code: UnsupportedLanguage},
+}
+
+// Register は生成された RegisterHandlersWithOptions でルートを登録します。
+// パラメータの形式が正しくない場合のエラーも ErrorHandler で application/problem+json にします
+func Register(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{ErrorHandler: ErrorHandler})
+}
+
+// ErrorHandler は生成されたコードがパラメータのバインドに失敗したときのエラーを返します
+func ErrorHandler(c *gin.Context, err error, statusCode int) {
+	problem(c, statusCode, InvalidParameter, err.Error())
+}
+
+// badRequest は err に対応するエラーコードで 400 を返します。対応するものがなければ invalid_parameter にします
+func badRequest(c *gin.Context, err error) {
+	code := InvalidParameter
+	for _, p := range problemCodes {
+		if errors.Is(err, p.err) {
+			code = p.code
+			break
+		}
+	}
+	problem(c, http.StatusBadRequest, code, err.Error())
+}
+
+// problem は RFC 7807 の形式でエラーを返します
+func problem(c *gin.Context, status int, code ProblemCode, detail string) {
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(status, Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: optionalString(detail),
+		Code:   code,
+	})
+}
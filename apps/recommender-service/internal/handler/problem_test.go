@@ -0,0 +1,67 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/kenji/baby-wear-translator/backend/internal/handler"
+)
+
+func TestProblemResponses(t *testing.T) {
+	r := setupRouter()
+
+	tests := []struct {
+		url        string
+		wantStatus int
+		wantCode   handler.ProblemCode
+	}{
+		// 生成されたコードでのパラメータのバインドエラー
+		{url: "/milestones", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/milestones?birth_date=2025/10/01", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/items/search?q=a&limit=x", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		// ハンドラーとドメインでの検証エラー
+		{url: "/milestones?birth_date=1900-01-01", wantStatus: http.StatusBadRequest, wantCode: handler.BirthDateOutOfRange},
+		{url: "/milestones?birth_date=2099-01-01", wantStatus: http.StatusBadRequest, wantCode: handler.BirthDateOutOfRange},
+		{url: "/outfit?birth_date=1900-01-01&date=1900-02-01", wantStatus: http.StatusBadRequest, wantCode: handler.BirthDateOutOfRange},
+		{url: "/milestones?birth_date=2025-10-01&due_date=2026-10-01", wantStatus: http.StatusBadRequest, wantCode: handler.DueDateOutOfRange},
+		{url: "/outfit?birth_date=2025-10-01&date=2025-09-01", wantStatus: http.StatusBadRequest, wantCode: handler.DateOutOfRange},
+		{url: "/milestones?birth_date=2025-10-01&to_month=100", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidMilestoneRange},
+		{url: "/milestones?birth_date=2025-10-01&granularity=daily", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/milestones?birth_date=2025-10-01&height_cm=5", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidMeasurement},
+		{url: "/milestones?birth_date=2025-10-01&temperature=80", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidTemperature},
+		{url: "/milestones?birth_date=2025-10-01&temperature_unit=kelvin", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/milestones?birth_date=2025-10-01&region=atlantis", wantStatus: http.StatusBadRequest, wantCode: handler.UnknownRegion},
+		{url: "/items?lang=fr", wantStatus: http.StatusBadRequest, wantCode: handler.UnsupportedLanguage},
+		{url: "/items/translate?name=x&shop=unknown", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/items/search?q=a&limit=0", wantStatus: http.StatusBadRequest, wantCode: handler.InvalidParameter},
+		{url: "/items/宇宙服", wantStatus: http.StatusNotFound, wantCode: handler.ItemNotFound},
+		{url: "/items/translate?name=宇宙服", wantStatus: http.StatusNotFound, wantCode: handler.ItemNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			w := doRequest(t, r, tt.url)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body = %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if got := w.Header().Get("Content-Type"); got != "application/problem+json" {
+				t.Errorf("Content-Type = %q, want application/problem+json", got)
+			}
+
+			var p handler.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+				t.Fatalf("json.Unmarshal: %v; body = %s", err, w.Body.String())
+			}
+			if p.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q (detail: %v)", p.Code, tt.wantCode, p.Detail)
+			}
+			if p.Type != "about:blank" || p.Status != tt.wantStatus || p.Title != http.StatusText(tt.wantStatus) {
+				t.Errorf("problem = %+v, want type about:blank, status %d, title %q", p, tt.wantStatus, http.StatusText(tt.wantStatus))
+			}
+			if p.Detail == nil || *p.Detail == "" {
+				t.Error("detail should be set")
+			}
+		})
+	}
+}
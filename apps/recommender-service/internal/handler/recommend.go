@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kenji/baby-wear-translator/backend/internal/domain"
@@ -9,67 +11,116 @@ import (
 )
 
 // RecommendHandler は ServerInterface を実装する構造体です
-type RecommendHandler struct{}
+type RecommendHandler struct {
+	temperature domain.TemperatureProvider
+	rules       *domain.RuleSet
+	catalog     func() *domain.Catalog
+	now         func() time.Time
+}
+
+// Option は RecommendHandler の設定を変更する関数です
+type Option func(*RecommendHandler)
+
+// WithTemperatureProvider は気温の推定に使う TemperatureProvider を差し替えます
+func WithTemperatureProvider(p domain.TemperatureProvider) Option {
+	return func(h *RecommendHandler) {
+		h.temperature = p
+	}
+}
+
+// WithRuleSet は推薦に使うルールセットを差し替えます
+func WithRuleSet(rs *domain.RuleSet) Option {
+	return func(h *RecommendHandler) {
+		h.rules = rs
+	}
+}
+
+// WithCatalog は固定のカタログを使うようにします
+func WithCatalog(c *domain.Catalog) Option {
+	return func(h *RecommendHandler) {
+		h.catalog = func() *domain.Catalog { return c }
+	}
+}
+
+// WithCatalogStore は CatalogStore の現在のカタログを使うようにします（リロードがすぐに反映されます）
+func WithCatalogStore(s *domain.CatalogStore) Option {
+	return func(h *RecommendHandler) {
+		h.catalog = s.Catalog
+	}
+}
 
-func NewRecommendHandler() *RecommendHandler {
-	return &RecommendHandler{}
+// WithClock は現在時刻の取得に使う関数を差し替えます（測定日や今日の日付の既定値に使います）
+func WithClock(now func() time.Time) Option {
+	return func(h *RecommendHandler) {
+		h.now = now
+	}
+}
+
+func NewRecommendHandler(opts ...Option) *RecommendHandler {
+	h := &RecommendHandler{
+		temperature: domain.ClimatologyProvider{},
+		rules:       domain.DefaultRuleSet(),
+		catalog:     domain.DefaultCatalog,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // GetMilestones は GET /milestones エンドポイントを処理します
 func (h *RecommendHandler) GetMilestones(c *gin.Context, params GetMilestonesParams) {
-	birthDate := params.BirthDate.Time
-
-	milestones := make([]Milestone, 0, 25)
-
-	// 0ヶ月から24ヶ月までの各ポイントでコーディネートを算出
-	for m := 0; m <= 24; m++ {
-		// その月齢になる日付を計算
-		targetDate := birthDate.AddDate(0, m, 0)
-
-		// 推測気温の計算
-		estimatedTemp := domain.EstimateTemperature(targetDate)
-
-		// 推奨アイテムの取得 (universal_name のリスト)
-		universalNames := domain.Recommend(m, estimatedTemp)
-
-		// アイテムの構築
-		items := make([]Item, 0, len(universalNames))
-		for _, uname := range universalNames {
-			// ショップごとの名前リストを構築
-			shopNames := make([]ShopNameStatus, 0)
-			if shopMap, ok := domain.ShopSpecificNames[uname]; ok {
-				for shopKey, sName := range shopMap {
-					shopNames = append(shopNames, ShopNameStatus{
-						ShopKey:  shopKey,
-						ShopName: sName,
-					})
-				}
-			}
-
-			// カテゴリー情報の取得
-			cat := domain.Category{
-				Label: "アイテム",
-				Emoji: "👕",
-				Color: "#F3F4F6",
-			}
-			if c, ok := domain.ItemCategories[uname]; ok {
-				cat = c
-			}
-
-			items = append(items, Item{
-				UniversalName: uname,
-				ShopNames:     shopNames,
-				CategoryLabel: cat.Label,
-				CategoryEmoji: cat.Emoji,
-				CategoryColor: cat.Color,
-			})
-		}
+	p, err := h.profile(c, profileParams{
+		BirthDate:       params.BirthDate,
+		Region:          params.Region,
+		DueDate:         params.DueDate,
+		HeightCm:        params.HeightCm,
+		WeightKg:        params.WeightKg,
+		Sex:             params.Sex,
+		MeasuredOn:      params.MeasuredOn,
+		Temperature:     params.Temperature,
+		TemperatureUnit: params.TemperatureUnit,
+		Lang:            params.Lang,
+	})
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	schedule, err := milestoneSchedule(params)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
 
+	// リクエストの途中でカタログが差し替わっても結果が混ざらないよう、最初に一度だけ取得する
+	catalog := h.catalog()
+
+	points := schedule.Points(p.birthDate)
+	milestones := make([]Milestone, 0, len(points))
+
+	// 各マイルストーンの時点でコーディネートを算出
+	// 週ごとのマイルストーンでは、同じ月齢の中でも伸びる身長を反映するため小数の月齢で身長を推定する
+	for _, point := range points {
+		day := h.buildDay(catalog, p, point.Date, point.Weeks != nil)
 		milestones = append(milestones, Milestone{
-			AgeInMonths: m,
-			TargetDate:  openapi_types.Date{Time: targetDate},
-			Size:        domain.EstimateSize(m),
-			Items:       items,
+			AgeInMonths:          day.age.Chronological,
+			AgeInWeeks:           point.Weeks,
+			CorrectedAgeInMonths: day.age.Corrected,
+			TargetDate:           openapi_types.Date{Time: point.Date},
+			Size:                 day.size.String(),
+			SizeDetail:           newSize(day.size),
+			EstimatedHeightCm:    day.heightCM,
+			Temperature:          newTemperature(day.temperature),
+			TemperatureSource:    day.source,
+			EstimatedTemperature: newTemperature(day.estimatedTemp),
+			Season:               Season(day.season),
+			SeasonLabel:          day.season.Label(p.lang),
+			TemperatureBand:      TemperatureBand(day.band),
+			TemperatureBandLabel: day.band.Label(p.lang),
+			Items:                day.items,
+			Outfits:              day.outfits,
 		})
 	}
 
@@ -80,3 +131,424 @@ func (h *RecommendHandler) GetMilestones(c *gin.Context, params GetMilestonesPar
 
 	c.JSON(http.StatusOK, resp)
 }
+
+// profileParams は GET /milestones と GET /outfit で共通の、赤ちゃんと表示についてのパラメータです
+type profileParams struct {
+	BirthDate  openapi_types.Date
+	Region     *string
+	DueDate    *openapi_types.Date
+	HeightCm   *float64
+	WeightKg   *float64
+	Sex        *Sex
+	MeasuredOn *openapi_types.Date
+	// Temperature は気温の指定（省略時は日付と地域から推定する）、TemperatureUnit はその単位です
+	Temperature     *float64
+	TemperatureUnit *TemperatureUnit
+	Lang            *string
+}
+
+// profile は検証済みの profileParams です
+type profile struct {
+	birthDate   time.Time
+	dueDate     *time.Time
+	region      domain.Region
+	lang        domain.Language
+	measurement *domain.Measurement
+	temperature domain.TemperatureProvider // 気温の指定があれば指定した気温を返す
+	source      TemperatureSource
+}
+
+// profile は共通のパラメータを検証して profile を組み立てます
+func (h *RecommendHandler) profile(c *gin.Context, params profileParams) (profile, error) {
+	p := profile{
+		birthDate:   params.BirthDate.Time,
+		region:      domain.DefaultRegion,
+		temperature: h.temperature,
+		source:      Estimated,
+	}
+	if err := domain.ValidateBirthDate(p.birthDate, h.today()); err != nil {
+		return profile{}, err
+	}
+
+	if params.Region != nil && *params.Region != "" {
+		r, err := domain.ParseRegion(*params.Region)
+		if err != nil {
+			return profile{}, err
+		}
+		p.region = r
+	}
+
+	lang, err := h.language(c, params.Lang)
+	if err != nil {
+		return profile{}, err
+	}
+	p.lang = lang
+
+	if params.DueDate != nil {
+		if err := domain.ValidateDueDate(p.birthDate, params.DueDate.Time); err != nil {
+			return profile{}, err
+		}
+		p.dueDate = &params.DueDate.Time
+	}
+
+	measurement, err := h.measurement(params, p.birthDate, p.dueDate)
+	if err != nil {
+		return profile{}, err
+	}
+	p.measurement = measurement
+
+	// 単位は気温の指定がなくても検証する（気温を省略した場合は使わない）
+	unit := domain.Celsius
+	if params.TemperatureUnit != nil {
+		u, err := domain.ParseTemperatureUnit(string(*params.TemperatureUnit))
+		if err != nil {
+			return profile{}, err
+		}
+		unit = u
+	}
+	if params.Temperature != nil {
+		celsius, err := unit.ToCelsius(*params.Temperature)
+		if err != nil {
+			return profile{}, err
+		}
+		p.temperature = domain.FixedTemperatureProvider{Mean: celsius, Base: h.temperature}
+		p.source = Specified
+	}
+	return p, nil
+}
+
+// day は1日分の月齢・サイズ・シーンごとのコーディネートです
+type day struct {
+	age           domain.Age
+	size          domain.Size
+	heightCM      *float64
+	temperature   domain.TemperatureEstimate // コーディネートに使った外気温
+	source        TemperatureSource
+	estimatedTemp domain.TemperatureEstimate // 日付と地域から推定した外気温
+	season        domain.Season
+	band          domain.TemperatureBand // コーディネートに使った日平均気温の区分
+	items         []Item                 // お出かけのコーディネートのアイテム
+	outfits       []Outfit
+}
+
+// buildDay は date の月齢・気温からサイズとシーンごとのコーディネートを算出します。
+// fractionalAge が true の場合は、身長の推定に整数の月齢ではなく小数の月齢を使います
+func (h *RecommendHandler) buildDay(catalog *domain.Catalog, p profile, date time.Time, fractionalAge bool) day {
+	// 月齢の計算（サイズとアイテムの判定には修正月齢を使う）
+	age := domain.CalculateAge(p.birthDate, p.dueDate, date)
+
+	// 推測気温の計算（気温の指定があれば、コーディネートには指定した気温を使う）
+	estimatedTemp := h.temperature.EstimateTemperature(p.region, date)
+	temp := p.temperature.EstimateTemperature(p.region, date)
+
+	// サイズの推定（身長・体重があれば発育曲線から推定した身長で決める）
+	size := domain.EstimateSize(age.Corrected)
+	var heightCM *float64
+	if p.measurement != nil {
+		projectAge := float64(age.Corrected)
+		if fractionalAge {
+			projectAge = max(0, domain.FractionalAgeInMonths(ageBase(p.birthDate, p.dueDate), date))
+		}
+		if height, ok := p.measurement.ProjectHeight(projectAge); ok {
+			size = domain.EstimateSizeFromHeight(height)
+			heightCM = &height
+		}
+	}
+
+	// シーン（室内・お出かけ・ねんね）ごとのコーディネートの構築
+	d := day{
+		age:           age,
+		size:          size,
+		heightCM:      heightCM,
+		temperature:   temp,
+		source:        p.source,
+		estimatedTemp: estimatedTemp,
+		season:        domain.SeasonOf(p.region, date),
+		band:          h.rules.TemperatureBand(temp.Mean),
+	}
+	d.outfits = make([]Outfit, 0, len(domain.Contexts))
+	for _, outfit := range h.rules.BuildOutfits(catalog, age.Corrected, size, temp) {
+		outfitItems := newItems(catalog, outfit.Items, size, p.lang)
+		d.outfits = append(d.outfits, Outfit{
+			Context:      OutfitContext(outfit.Context),
+			Label:        outfit.Context.Label(p.lang),
+			Temperature:  newTemperature(outfit.Temperature),
+			Items:        outfitItems,
+			Warmth:       outfit.Warmth,
+			TargetWarmth: outfit.TargetWarmth,
+			Alternatives: newAlternatives(catalog, outfit.Alternatives, size, p.lang),
+		})
+		// items は従来どおり外気温で選んだお出かけのコーディネートを返す
+		if outfit.Context == domain.ContextOuting {
+			d.items = outfitItems
+		}
+	}
+	return d
+}
+
+// newItems はコーディネートのアイテムをレスポンスの形式に変換します
+func newItems(catalog *domain.Catalog, outfitItems []domain.OutfitItem, size domain.Size, lang domain.Language) []Item {
+	items := make([]Item, 0, len(outfitItems))
+	for _, rec := range outfitItems {
+		uname := rec.Item
+
+		// ショップごとの名前と、そのサイズを扱っているか・買える商品のリストを構築（ショップの表示順）
+		shopNames := newShopNames(catalog, uname, size)
+
+		// カテゴリー情報の取得
+		cat := categoryOf(catalog, uname)
+
+		// 表示名と説明の取得（カタログにないアイテムは汎用名をそのまま表示する）
+		entry, ok := catalog.Item(uname)
+		if !ok {
+			entry = domain.CatalogItem{Name: uname}
+		}
+
+		items = append(items, Item{
+			UniversalName: uname,
+			DisplayName:   entry.DisplayName(lang),
+			Description:   optionalString(entry.Description(lang)),
+			ShopNames:     shopNames,
+			CategoryLabel: cat.LocalLabel(lang),
+			CategoryEmoji: cat.Emoji,
+			CategoryColor: cat.Color,
+			Reason:        newReason(rec.Reason, lang),
+			Layer:         optionalLayer(rec.Layer),
+			Warmth:        rec.Warmth,
+			Replaces:      optionalString(rec.Replaces),
+			Equivalents:   newEquivalentItems(catalog, rec.Equivalents, lang),
+		})
+	}
+	return items
+}
+
+// newAlternatives は代わりのコーディネートをレスポンスの形式に変換します
+func newAlternatives(catalog *domain.Catalog, alts []domain.AlternativeOutfit, size domain.Size, lang domain.Language) []AlternativeOutfit {
+	alternatives := make([]AlternativeOutfit, 0, len(alts))
+	for _, alt := range alts {
+		subs := make([]Substitution, 0, len(alt.Substitutions))
+		for _, s := range alt.Substitutions {
+			subs = append(subs, Substitution{From: s.From, To: s.To})
+		}
+		alternatives = append(alternatives, AlternativeOutfit{
+			Items:         newItems(catalog, alt.Items, size, lang),
+			Warmth:        alt.Warmth,
+			Substitutions: subs,
+		})
+	}
+	return alternatives
+}
+
+// newEquivalentItems は代わりに着られるアイテムをレスポンスの形式に変換します
+func newEquivalentItems(catalog *domain.Catalog, names []string, lang domain.Language) []Equivalent {
+	equivalents := make([]Equivalent, 0, len(names))
+	for _, name := range names {
+		entry, ok := catalog.Item(name)
+		if !ok {
+			continue
+		}
+		equivalents = append(equivalents, Equivalent{
+			UniversalName: name,
+			DisplayName:   entry.DisplayName(lang),
+			Warmth:        entry.Warmth,
+		})
+	}
+	return equivalents
+}
+
+// optionalLayer はレイヤーが未設定（空）の場合に nil（レスポンスでは省略）を返します
+func optionalLayer(l domain.Layer) *Layer {
+	if l == "" {
+		return nil
+	}
+	layer := Layer(l)
+	return &layer
+}
+
+// language は lang パラメータ、なければ Accept-Language ヘッダーから表示言語を決め、Content-Language ヘッダーに設定します
+func (h *RecommendHandler) language(c *gin.Context, param *string) (domain.Language, error) {
+	lang := domain.NegotiateLanguage(c.GetHeader("Accept-Language"))
+	if param != nil && *param != "" {
+		l, err := domain.ParseLanguage(*param)
+		if err != nil {
+			return "", err
+		}
+		lang = l
+	}
+	c.Header("Content-Language", string(lang))
+	return lang, nil
+}
+
+// measurement は身長・体重のパラメータから測定値を組み立てます。どちらも指定がなければ nil を返します
+func (h *RecommendHandler) measurement(params profileParams, birthDate time.Time, dueDate *time.Time) (*domain.Measurement, error) {
+	if params.HeightCm == nil && params.WeightKg == nil {
+		return nil, nil
+	}
+
+	measuredOn := h.now()
+	if params.MeasuredOn != nil {
+		measuredOn = params.MeasuredOn.Time
+	}
+	if measuredOn.Before(birthDate) {
+		return nil, fmt.Errorf("%w: measured_on must not be before birth_date", domain.ErrInvalidMeasurement)
+	}
+
+	m := &domain.Measurement{
+		AgeInMonths: max(0, domain.FractionalAgeInMonths(ageBase(birthDate, dueDate), measuredOn)),
+		HeightCM:    params.HeightCm,
+		WeightKG:    params.WeightKg,
+	}
+	if params.Sex != nil {
+		m.Sex = domain.Sex(*params.Sex)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// today は now の日付を、日付のパラメータと同じ形式（UTC の0時）で返します
+func (h *RecommendHandler) today() time.Time {
+	now := h.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ageBase は発育曲線と比べる月齢の起点を返します（早産児の場合は出産予定日を起点にした修正月齢で比べる）
+func ageBase(birthDate time.Time, dueDate *time.Time) time.Time {
+	if dueDate != nil && dueDate.After(birthDate) {
+		return *dueDate
+	}
+	return birthDate
+}
+
+// milestoneSchedule は from_month・to_month・step・granularity のパラメータからマイルストーンの範囲を組み立てます
+func milestoneSchedule(params GetMilestonesParams) (domain.MilestoneSchedule, error) {
+	s := domain.DefaultMilestoneSchedule
+	if params.FromMonth != nil {
+		s.FromMonth = *params.FromMonth
+	}
+	if params.ToMonth != nil {
+		s.ToMonth = *params.ToMonth
+	}
+	if params.Step != nil {
+		s.Step = *params.Step
+	}
+	if params.Granularity != nil {
+		g, err := domain.ParseGranularity(string(*params.Granularity))
+		if err != nil {
+			return domain.MilestoneSchedule{}, err
+		}
+		s.Granularity = g
+	}
+	if err := s.Validate(); err != nil {
+		return domain.MilestoneSchedule{}, err
+	}
+	return s, nil
+}
+
+// defaultCategory はカテゴリーが見つからないアイテムに使う表示情報です
+var defaultCategory = domain.Category{
+	Label:  "アイテム",
+	Labels: domain.LocalizedText{domain.LangEN: "Item", domain.LangZH: "单品", domain.LangKO: "아이템", domain.LangVI: "Món đồ"},
+	Emoji:  "👕",
+	Color:  "#F3F4F6",
+}
+
+// categoryOf はアイテムのカテゴリー情報を返します。見つからない場合は defaultCategory を返します
+func categoryOf(catalog *domain.Catalog, uname string) domain.Category {
+	if c, ok := catalog.CategoryOf(uname); ok {
+		return c
+	}
+	return defaultCategory
+}
+
+// newShopNames はアイテムのショップごとの名前・サイズの取り扱いの有無・サイズに合う商品を、カタログのショップの表示順で返します
+func newShopNames(catalog *domain.Catalog, uname string, size domain.Size) []ShopNameStatus {
+	shopNames := make([]ShopNameStatus, 0, len(catalog.Shops))
+	entry, ok := catalog.Item(uname)
+	if !ok {
+		return shopNames
+	}
+	for _, shop := range catalog.Shops {
+		shopNames = append(shopNames, ShopNameStatus{
+			ShopKey:     shop.Key,
+			ShopName:    entry.ShopNames[shop.Key],
+			DisplayName: shop.DisplayName,
+			LogoUrl:     optionalString(shop.LogoURL),
+			BrandColor:  optionalString(shop.BrandColor),
+			Priority:    shop.Priority,
+			Available:   catalog.Available(uname, shop.Key, size),
+			Offers:      newOffers(catalog.Offers(uname, shop.Key, size)),
+		})
+	}
+	return shopNames
+}
+
+// newOffers はカタログの商品をレスポンスの形式に変換します
+func newOffers(products []domain.Product) []Offer {
+	offers := make([]Offer, 0, len(products))
+	for _, p := range products {
+		offers = append(offers, Offer{
+			Sku:      optionalString(p.SKU),
+			Jan:      optionalString(p.JAN),
+			Url:      p.URL,
+			Price:    PriceRange{Min: p.Price.Min, Max: p.Price.Max, Currency: "JPY"},
+			Sizes:    p.Sizes,
+			Material: optionalString(p.Material),
+		})
+	}
+	return offers
+}
+
+// optionalString は空文字を nil（レスポンスでは省略）に変換します
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// newSize はドメインのサイズをレスポンスの形式に変換します
+func newSize(s domain.Size) Size {
+	return Size{
+		Label: s.Label,
+		MinCm: s.MinCM,
+		MaxCm: s.MaxCM,
+		Conversions: SizeConversions{
+			Us: s.USSizes(),
+			Eu: s.EUSizes(),
+		},
+	}
+}
+
+// newReason はドメインの推薦理由を、lang の文章を付けたレスポンスの形式に変換します
+func newReason(r domain.Reason, lang domain.Language) Reason {
+	return Reason{
+		RuleId:           r.RuleID,
+		RuleGroup:        r.Group,
+		AgeBand:          newRange(r.AgeBand),
+		Temperature:      r.Temperature,
+		TemperatureBasis: ReasonTemperatureBasis(r.TemperatureBasis),
+		TemperatureBand:  newRange(r.TemperatureBand),
+		TargetWarmth:     optionalWarmth(r.TargetWarmth),
+		Message:          r.Message(lang),
+	}
+}
+
+// optionalWarmth は暖かさが 0 の場合に nil を返します
+func optionalWarmth(v float64) *float64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+// newTemperature はドメインの気温をレスポンスの形式に変換します
+func newTemperature(t domain.TemperatureEstimate) Temperature {
+	return Temperature{Mean: t.Mean, Min: t.Min, Max: t.Max}
+}
+
+// newRange はドメインの範囲をレスポンスの形式に変換します
+func newRange(b domain.Band) Range {
+	return Range{Min: b.Min, Max: b.Max}
+}
@@ -2,20 +2,31 @@ package handler_test
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/kenji/baby-wear-translator/backend/internal/domain"
 	"github.com/kenji/baby-wear-translator/backend/internal/handler"
 )
 
+// testNow はテストでの現在時刻です。生年月日は今日を基準に検証するため、
+// テストの日付が実行する日によって範囲外にならないように固定します。
+func testNow() time.Time {
+	return time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+}
+
 // setupRouter はテスト用の Gin ルーターをセットアップして返します。
 func setupRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
-	h := handler.NewRecommendHandler()
-	handler.RegisterHandlers(r, h)
+	h := handler.NewRecommendHandler(handler.WithClock(testNow))
+	handler.Register(r, h)
 	return r
 }
 
@@ -167,3 +178,685 @@ func TestGetMilestones_BadRequest_InvalidDateFormat(t *testing.T) {
 		t.Errorf("status = %d, want %d (invalid date format should be rejected)", w.Code, http.StatusBadRequest)
 	}
 }
+
+func TestGetMilestones_OK_Region(t *testing.T) {
+	r := setupRouter()
+
+	// 4月下旬生まれの新生児: 札幌ではカバーオール、那覇では不要
+	tests := []struct {
+		region      string
+		wantOuter   bool
+		description string
+	}{
+		{region: "hokkaido", wantOuter: true, description: "札幌の4月は寒い"},
+		{region: "沖縄県", wantOuter: false, description: "那覇の4月は温暖"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			w := doRequest(t, r, "/milestones?birth_date=2026-04-20&region="+url.QueryEscape(tt.region))
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+			}
+
+			var resp handler.MilestoneResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+
+			hasOuter := false
+			for _, item := range resp.Milestones[0].Items {
+				if item.UniversalName == "カバーオール" {
+					hasOuter = true
+				}
+			}
+			if hasOuter != tt.wantOuter {
+				t.Errorf("%s: カバーオール含有 = %v, want %v", tt.description, hasOuter, tt.wantOuter)
+			}
+		})
+	}
+}
+
+func TestGetMilestones_BadRequest_UnknownRegion(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&region=atlantis")
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d (unknown region should be rejected)", w.Code, http.StatusBadRequest)
+	}
+}
+
+// fixedTemperature は常に同じ気温を返すテスト用の TemperatureProvider です。
+type fixedTemperature struct {
+	temp float64
+}
+
+func (f fixedTemperature) EstimateTemperature(domain.Region, time.Time) domain.TemperatureEstimate {
+	return domain.TemperatureEstimate{Mean: f.temp, Min: f.temp, Max: f.temp}
+}
+
+func TestGetMilestones_OK_TemperatureProvider(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	h := handler.NewRecommendHandler(handler.WithTemperatureProvider(fixedTemperature{temp: 0}), handler.WithClock(testNow))
+	handler.Register(r, h)
+
+	w := doRequest(t, r, "/milestones?birth_date=2025-07-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	// 真夏生まれでも、プロバイダーが0℃を返すなら全マイルストーンで靴下が推薦される
+	for _, m := range resp.Milestones {
+		found := false
+		for _, item := range m.Items {
+			if item.UniversalName == "靴下" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("milestone %d: 靴下 が推薦されていません", m.AgeInMonths)
+		}
+	}
+}
+
+func TestGetMilestones_OK_Outfits(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	h := handler.NewRecommendHandler(handler.WithTemperatureProvider(fixedTemperature{temp: 0}), handler.WithClock(testNow))
+	handler.Register(r, h)
+
+	w := doRequest(t, r, "/milestones?birth_date=2025-07-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	names := func(items []handler.Item) []string {
+		var s []string
+		for _, item := range items {
+			s = append(s, item.UniversalName)
+		}
+		return s
+	}
+
+	m := resp.Milestones[0]
+	wantContexts := []handler.OutfitContext{handler.Indoor, handler.Outing, handler.Sleep}
+	if len(m.Outfits) != len(wantContexts) {
+		t.Fatalf("len(outfits) = %d, want %d", len(m.Outfits), len(wantContexts))
+	}
+	for i, want := range wantContexts {
+		if m.Outfits[i].Context != want {
+			t.Errorf("outfits[%d].context = %q, want %q", i, m.Outfits[i].Context, want)
+		}
+	}
+
+	// 外が0℃でも室内は暖房で20℃とみなすので、カバーオールは不要
+	indoor := m.Outfits[0]
+	if indoor.Temperature.Mean != 20 || indoor.Label != "室内" {
+		t.Errorf("indoor = {temperature.mean: %v, label: %q}, want 20 / 室内", indoor.Temperature.Mean, indoor.Label)
+	}
+	if slices.Contains(names(indoor.Items), "カバーオール") {
+		t.Errorf("indoor items = %v, should NOT contain カバーオール", names(indoor.Items))
+	}
+
+	// お出かけは外気温で選び、従来の items と同じ
+	outing := m.Outfits[1]
+	if !slices.Contains(names(outing.Items), "カバーオール") {
+		t.Errorf("outing items = %v, want to contain カバーオール", names(outing.Items))
+	}
+	if !slices.Equal(names(outing.Items), names(m.Items)) {
+		t.Errorf("items = %v, want the outing items %v", names(m.Items), names(outing.Items))
+	}
+
+	// ねんねは掛け布団の代わりにスリーパー
+	sleep := m.Outfits[2]
+	if !slices.Contains(names(sleep.Items), "スリーパー") {
+		t.Errorf("sleep items = %v, want to contain スリーパー", names(sleep.Items))
+	}
+
+	// 置き換え表で作った代わりのコーディネートが付く（6ヶ月のカバーオールはロンパース+レッグウォーマーで代えられる）
+	outing6 := resp.Milestones[6].Outfits[1]
+	if len(outing6.Alternatives) == 0 {
+		t.Fatal("outing outfit at 6 months should have alternatives")
+	}
+	for _, alt := range outing6.Alternatives {
+		if len(alt.Substitutions) == 0 || len(alt.Items) == 0 || alt.Warmth < min(outing6.Warmth, outing6.TargetWarmth) {
+			t.Errorf("alternative = {items: %v, warmth: %v, substitutions: %+v}", names(alt.Items), alt.Warmth, alt.Substitutions)
+		}
+	}
+
+	// アイテムにはレイヤーと暖かさが付き、コーディネートには暖かさの合計と目標が付く
+	for _, outfit := range m.Outfits {
+		var warmth float64
+		for _, item := range outfit.Items {
+			if item.Layer == nil || item.Warmth <= 0 || item.Equivalents == nil {
+				t.Errorf("%s: item %s = {layer: %v, warmth: %v, equivalents: %v}, want layer, warmth and equivalents",
+					outfit.Context, item.UniversalName, item.Layer, item.Warmth, item.Equivalents)
+			}
+			warmth += item.Warmth
+		}
+		if math.Abs(outfit.Warmth-warmth) > 1e-9 || outfit.TargetWarmth <= 0 {
+			t.Errorf("%s: warmth = %v (sum %v), target_warmth = %v", outfit.Context, outfit.Warmth, warmth, outfit.TargetWarmth)
+		}
+	}
+}
+
+func TestGetMilestones_OK_Reason(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	for _, m := range resp.Milestones {
+		for _, item := range m.Items {
+			if item.Reason.RuleId == "" || item.Reason.Message == "" {
+				t.Errorf("milestone %d, item %q: reason is missing: %+v", m.AgeInMonths, item.UniversalName, item.Reason)
+			}
+		}
+	}
+}
+
+func TestGetMilestones_OK_CorrectedAge(t *testing.T) {
+	r := setupRouter()
+	// 予定日より2ヶ月早く生まれた子
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&due_date=2025-12-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	m4 := resp.Milestones[4]
+	if m4.AgeInMonths != 4 || m4.CorrectedAgeInMonths != 2 {
+		t.Errorf("milestone[4]: age=%d corrected=%d, want 4 and 2", m4.AgeInMonths, m4.CorrectedAgeInMonths)
+	}
+	// サイズとアイテムは修正月齢（2ヶ月）で決まる
+	if m4.Size != "50-60cm" {
+		t.Errorf("milestone[4].size = %q, want %q", m4.Size, "50-60cm")
+	}
+	for _, item := range m4.Items {
+		if item.UniversalName == "ボディースーツ" {
+			t.Errorf("milestone[4] should not recommend ボディースーツ (corrected age 2)")
+		}
+	}
+}
+
+func TestGetMilestones_BadRequest_DueDateOutOfRange(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&due_date=2026-10-01")
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d (unrealistic due date should be rejected)", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetMilestones_OK_GrowthCurveSize(t *testing.T) {
+	r := setupRouter()
+
+	// 3ヶ月健診で 97 パーセンタイルを超える身長の男の子
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&height_cm=67&sex=male&measured_on=2026-01-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	m9 := resp.Milestones[9]
+	if m9.EstimatedHeightCm == nil {
+		t.Fatal("milestone[9].estimated_height_cm should be set")
+	}
+	if *m9.EstimatedHeightCm < 75 {
+		t.Errorf("milestone[9].estimated_height_cm = %.1f, want >= 75 (tall baby)", *m9.EstimatedHeightCm)
+	}
+	// 月齢だけなら 70-80cm だが、背が高いので 80cm
+	if m9.Size != "80cm" {
+		t.Errorf("milestone[9].size = %q, want %q", m9.Size, "80cm")
+	}
+}
+
+func TestGetMilestones_BadRequest_InvalidMeasurement(t *testing.T) {
+	r := setupRouter()
+
+	for _, query := range []string{
+		"height_cm=5",
+		"weight_kg=6&measured_on=2025-09-01",
+		"height_cm=60&sex=unknown",
+	} {
+		t.Run(query, func(t *testing.T) {
+			w := doRequest(t, r, "/milestones?birth_date=2025-10-01&"+query)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestGetMilestones_OK_SizeDetail(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	m0 := resp.Milestones[0]
+	if m0.Size != "50-60cm" {
+		t.Errorf("milestone[0].size = %q, want legacy label %q", m0.Size, "50-60cm")
+	}
+	if m0.SizeDetail.MinCm != 50 || m0.SizeDetail.MaxCm != 60 || m0.SizeDetail.Label != "50-60" {
+		t.Errorf("milestone[0].size_detail = %+v, want 50-60", m0.SizeDetail)
+	}
+	if len(m0.SizeDetail.Conversions.Us) == 0 || len(m0.SizeDetail.Conversions.Eu) == 0 {
+		t.Errorf("milestone[0].size_detail.conversions should not be empty: %+v", m0.SizeDetail.Conversions)
+	}
+}
+
+// TestGetMilestones_OK_ShopOrder は shop_names がショップの表示順で、リクエストごとに変わらないことを確認します。
+func TestGetMilestones_OK_ShopOrder(t *testing.T) {
+	r := setupRouter()
+	want := []string{"nishimatsuya", "uniqlo", "akachan_honpo"}
+
+	for range 10 {
+		w := doRequest(t, r, "/milestones?birth_date=2025-10-01")
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+		}
+
+		var resp handler.MilestoneResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("json.Unmarshal: %v", err)
+		}
+
+		for _, item := range resp.Milestones[0].Items {
+			var keys []string
+			for _, sn := range item.ShopNames {
+				keys = append(keys, sn.ShopKey)
+				if sn.DisplayName == "" {
+					t.Errorf("item %q: shop %q has no display_name", item.UniversalName, sn.ShopKey)
+				}
+			}
+			if !slices.Equal(keys, want) {
+				t.Fatalf("item %q: shop order = %v, want %v", item.UniversalName, keys, want)
+			}
+		}
+	}
+}
+
+// TestGetMilestones_OK_Offers はマイルストーンのサイズに合う商品だけが offers に含まれることを確認します。
+func TestGetMilestones_OK_Offers(t *testing.T) {
+	catalog, err := domain.ParseCatalog([]byte(`
+version: 2
+shops:
+  - { key: nishimatsuya, display_name: 西松屋 }
+categories:
+  inner: { label: インナー }
+items:
+  - name: 短肌着
+    category: inner
+    shop_names: { nishimatsuya: 短肌着 }
+    products:
+      - { shop: nishimatsuya, sku: newborn, url: "https://example.com/newborn", price: { min: 300, max: 400 }, sizes: [50, 60], material: 綿100% }
+      - { shop: nishimatsuya, sku: baby, url: "https://example.com/baby", price: { min: 500, max: 500 }, sizes: [70] }
+`))
+	if err != nil {
+		t.Fatalf("ParseCatalog: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	handler.Register(r, handler.NewRecommendHandler(handler.WithCatalog(catalog), handler.WithClock(testNow)))
+
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	// 0ヶ月（50-60cm）の短肌着には新生児サイズの商品だけが出る
+	var offers []handler.Offer
+	for _, item := range resp.Milestones[0].Items {
+		if item.UniversalName == "短肌着" {
+			offers = item.ShopNames[0].Offers
+		}
+	}
+	if len(offers) != 1 || offers[0].Sku == nil || *offers[0].Sku != "newborn" {
+		t.Fatalf("milestone[0] 短肌着 offers = %+v, want [newborn]", offers)
+	}
+	if offers[0].Price.Min != 300 || offers[0].Price.Max != 400 || offers[0].Price.Currency != "JPY" {
+		t.Errorf("offer price = %+v, want 300-400 JPY", offers[0].Price)
+	}
+	if offers[0].Material == nil || *offers[0].Material != "綿100%" {
+		t.Errorf("offer material = %v, want 綿100%%", offers[0].Material)
+	}
+}
+
+// TestGetMilestones_OK_ShopAvailability はマイルストーンのサイズを扱っていないショップが available=false になることを確認します。
+func TestGetMilestones_OK_ShopAvailability(t *testing.T) {
+	r := setupRouter()
+	// 9ヶ月（70-80cm）が1月になる誕生日。カバーオールが推薦される
+	w := doRequest(t, r, "/milestones?birth_date=2025-04-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	m9 := resp.Milestones[9]
+	if m9.SizeDetail.Label != "70-80" {
+		t.Fatalf("milestone[9].size_detail.label = %q, want 70-80", m9.SizeDetail.Label)
+	}
+
+	want := map[string]bool{"nishimatsuya": true, "uniqlo": true, "akachan_honpo": false}
+	found := false
+	for _, item := range m9.Items {
+		if item.UniversalName != "カバーオール" {
+			continue
+		}
+		found = true
+		for _, sn := range item.ShopNames {
+			if sn.Available != want[sn.ShopKey] {
+				t.Errorf("カバーオール at %s: available = %v, want %v", sn.ShopKey, sn.Available, want[sn.ShopKey])
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("milestone[9] should recommend カバーオール, got %+v", m9.Items)
+	}
+}
+
+func TestGetMilestones_OK_Language(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&lang=en")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	item := resp.Milestones[0].Items[0]
+	if item.UniversalName != "短肌着" || item.DisplayName != "Short undershirt (短肌着)" {
+		t.Errorf("item = {universal_name: %q, display_name: %q}, want 短肌着 / Short undershirt (短肌着)", item.UniversalName, item.DisplayName)
+	}
+	if item.CategoryLabel != "Inner layer" || item.Description == nil {
+		t.Errorf("category_label = %q, description = %v, want English", item.CategoryLabel, item.Description)
+	}
+	// ショップ固有名はお店で目にする日本語のまま
+	if item.ShopNames[1].ShopName != "コットン前開き短肌着" {
+		t.Errorf("shop_name = %q, want Japanese", item.ShopNames[1].ShopName)
+	}
+	// 推薦理由の文章もラベルと同じ言語で返す
+	if want := "Recommended for age 0 mo (below 4 mo), estimated temperature 20.2℃ (below 25℃)"; item.Reason.Message != want {
+		t.Errorf("reason.message = %q, want %q", item.Reason.Message, want)
+	}
+
+	if w := doRequest(t, r, "/milestones?birth_date=2025-10-01&lang=fr"); w.Code != http.StatusBadRequest {
+		t.Errorf("lang=fr: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetMilestones_OK_Range(t *testing.T) {
+	r := setupRouter()
+
+	tests := []struct {
+		query    string
+		wantAges []int
+		wantSize string // 最後のマイルストーンのサイズのラベル
+	}{
+		{query: "from_month=24&to_month=72&step=12", wantAges: []int{24, 36, 48, 60, 72}, wantSize: "120"},
+		{query: "from_month=6&to_month=9", wantAges: []int{6, 7, 8, 9}, wantSize: "70-80"},
+		{query: "to_month=3&step=2", wantAges: []int{0, 2}, wantSize: "50-60"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			w := doRequest(t, r, "/milestones?birth_date=2025-10-01&"+tt.query)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+			}
+			var resp handler.MilestoneResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+
+			var ages []int
+			for _, m := range resp.Milestones {
+				ages = append(ages, m.AgeInMonths)
+				if m.AgeInWeeks != nil {
+					t.Errorf("milestone %d months has age_in_weeks = %d, want omitted", m.AgeInMonths, *m.AgeInWeeks)
+				}
+				if len(m.Outfits) != len(domain.Contexts) {
+					t.Errorf("milestone %d months has %d outfits, want %d", m.AgeInMonths, len(m.Outfits), len(domain.Contexts))
+				}
+			}
+			if !slices.Equal(ages, tt.wantAges) {
+				t.Errorf("ages = %v, want %v", ages, tt.wantAges)
+			}
+			if got := resp.Milestones[len(resp.Milestones)-1].SizeDetail.Label; got != tt.wantSize {
+				t.Errorf("last size = %q, want %q", got, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestGetMilestones_OK_WeeklyGranularity(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&to_month=6&granularity=weekly&height_cm=50&measured_on=2025-10-01")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	// 0〜12週の13件と、3〜6ヶ月の4件（13週は3ヶ月の前日なので返さない）
+	if len(resp.Milestones) != 17 {
+		t.Fatalf("len(milestones) = %d, want 17", len(resp.Milestones))
+	}
+	var prevHeight float64
+	for i, m := range resp.Milestones[:13] {
+		if m.AgeInWeeks == nil || *m.AgeInWeeks != i {
+			t.Errorf("milestone[%d].age_in_weeks = %v, want %d", i, m.AgeInWeeks, i)
+		}
+		if want := time.Date(2025, 10, 1+7*i, 0, 0, 0, 0, time.UTC); !m.TargetDate.Equal(want) {
+			t.Errorf("milestone[%d].target_date = %s, want %s", i, m.TargetDate, want.Format(time.DateOnly))
+		}
+		// 週ごとに推定身長が伸びる
+		if m.EstimatedHeightCm == nil || *m.EstimatedHeightCm <= prevHeight {
+			t.Errorf("milestone[%d].estimated_height_cm = %v, want > %.1f", i, m.EstimatedHeightCm, prevHeight)
+		} else {
+			prevHeight = *m.EstimatedHeightCm
+		}
+	}
+	for i, m := range resp.Milestones[13:] {
+		if m.AgeInWeeks != nil {
+			t.Errorf("milestone[%d].age_in_weeks = %d, want omitted", 13+i, *m.AgeInWeeks)
+		}
+		if m.AgeInMonths != 3+i {
+			t.Errorf("milestone[%d].age_in_months = %d, want %d", 13+i, m.AgeInMonths, 3+i)
+		}
+	}
+}
+
+func TestGetMilestones_BadRequest_InvalidRange(t *testing.T) {
+	r := setupRouter()
+
+	for _, query := range []string{
+		"from_month=-1",
+		"to_month=73",
+		"from_month=12&to_month=6",
+		"step=0",
+		"step=abc",
+		"granularity=daily",
+	} {
+		t.Run(query, func(t *testing.T) {
+			w := doRequest(t, r, "/milestones?birth_date=2025-10-01&"+query)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestGetMilestones_OK_SpecifiedTemperature(t *testing.T) {
+	r := setupRouter()
+
+	tests := []struct {
+		query    string
+		wantMean float64
+	}{
+		{query: "temperature=8", wantMean: 8},
+		{query: "temperature=8&temperature_unit=celsius", wantMean: 8},
+		{query: "temperature=46.4&temperature_unit=fahrenheit", wantMean: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			// 真夏生まれでも、指定した 8℃ で全マイルストーンのコーディネートを選ぶ
+			w := doRequest(t, r, "/milestones?birth_date=2025-07-01&to_month=2&"+tt.query)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d; body = %s", w.Code, http.StatusOK, w.Body.String())
+			}
+			var resp handler.MilestoneResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+			for _, m := range resp.Milestones {
+				if math.Abs(m.Temperature.Mean-tt.wantMean) > 1e-9 {
+					t.Errorf("milestone %d: temperature.mean = %v, want %v", m.AgeInMonths, m.Temperature.Mean, tt.wantMean)
+				}
+				if m.TemperatureSource != handler.Specified {
+					t.Errorf("milestone %d: temperature_source = %q, want %q", m.AgeInMonths, m.TemperatureSource, handler.Specified)
+				}
+				if m.Outfits[1].Temperature.Mean != m.Temperature.Mean {
+					t.Errorf("milestone %d: outing temperature = %v, want %v", m.AgeInMonths, m.Outfits[1].Temperature.Mean, m.Temperature.Mean)
+				}
+				if !slices.ContainsFunc(m.Items, func(i handler.Item) bool { return i.UniversalName == "カバーオール" }) {
+					t.Errorf("milestone %d: カバーオール が推薦されていません", m.AgeInMonths)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMilestones_OK_EstimatedTemperatureSource(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-07-01&to_month=0")
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	m0 := resp.Milestones[0]
+	want := domain.EstimateTemperature(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+	if m0.TemperatureSource != handler.Estimated || m0.Temperature.Mean != want.Mean {
+		t.Errorf("temperature = %+v (%s), want %+v (estimated)", m0.Temperature, m0.TemperatureSource, want)
+	}
+}
+
+func TestGetMilestones_BadRequest_InvalidTemperature(t *testing.T) {
+	r := setupRouter()
+
+	for _, query := range []string{
+		"temperature=abc",
+		"temperature=80",
+		"temperature=NaN",
+		"temperature=8&temperature_unit=kelvin",
+		// 気温を省略しても単位は検証する
+		"temperature_unit=kelvin",
+	} {
+		t.Run(query, func(t *testing.T) {
+			w := doRequest(t, r, "/milestones?birth_date=2025-10-01&"+query)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestGetMilestones_OK_SeasonAndTemperatureBand(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-10-01&to_month=9")
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	tests := []struct {
+		index     int
+		season    handler.Season
+		label     string
+		band      handler.TemperatureBand
+		bandLabel string
+	}{
+		{index: 0, season: handler.Autumn, label: "秋", band: handler.Mild, bandLabel: "過ごしやすい"},
+		{index: 4, season: handler.Winter, label: "冬", band: handler.Cold, bandLabel: "寒い"},
+		{index: 9, season: handler.RainySeason, label: "梅雨", band: handler.Mild, bandLabel: "過ごしやすい"},
+	}
+	for _, tt := range tests {
+		m := resp.Milestones[tt.index]
+		if m.Season != tt.season || m.SeasonLabel != tt.label {
+			t.Errorf("milestone[%d] season = %q %q, want %q %q", tt.index, m.Season, m.SeasonLabel, tt.season, tt.label)
+		}
+		if m.TemperatureBand != tt.band || m.TemperatureBandLabel != tt.bandLabel {
+			t.Errorf("milestone[%d] temperature_band = %q %q (%.1f℃), want %q %q",
+				tt.index, m.TemperatureBand, m.TemperatureBandLabel, m.Temperature.Mean, tt.band, tt.bandLabel)
+		}
+		if m.EstimatedTemperature != m.Temperature {
+			t.Errorf("milestone[%d] estimated_temperature = %+v, want the same as temperature %+v", tt.index, m.EstimatedTemperature, m.Temperature)
+		}
+	}
+}
+
+func TestGetMilestones_OK_SeasonWithSpecifiedTemperature(t *testing.T) {
+	r := setupRouter()
+	w := doRequest(t, r, "/milestones?birth_date=2025-07-01&to_month=0&temperature=8&lang=en")
+	var resp handler.MilestoneResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	// 季節は日付で決まり、気温の区分は指定した気温で決まる。推定気温は平年値のまま
+	m0 := resp.Milestones[0]
+	if m0.Season != handler.RainySeason || m0.SeasonLabel != "Rainy season" {
+		t.Errorf("season = %q %q, want rainy_season", m0.Season, m0.SeasonLabel)
+	}
+	if m0.TemperatureBand != handler.Cold || m0.TemperatureBandLabel != "Cold" {
+		t.Errorf("temperature_band = %q %q, want cold", m0.TemperatureBand, m0.TemperatureBandLabel)
+	}
+	want := domain.EstimateTemperature(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+	if m0.EstimatedTemperature.Mean != want.Mean {
+		t.Errorf("estimated_temperature.mean = %v, want %v", m0.EstimatedTemperature.Mean, want.Mean)
+	}
+}